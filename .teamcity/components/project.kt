@@ -7,6 +7,7 @@ var services = mapOf(
         "applications" to "Applications",
         "domains" to "Domains",
         "groups" to "Groups",
+        "policies" to "Policies",
         "serviceprincipals" to "Service Principals",
         "users" to "Users"
 )
@@ -30,21 +30,23 @@ resource "azuread_application" "widgets_app" {
   display_name = "widgets-app"
   type         = "webapp/api"
 
-  logout_url = "https://widgets.example.net/logout"
-  reply_urls = [
-    "https://widgets.example.net/",
-    "https://widgets.example.net/login",
-  ]
+  web {
+    logout_url = "https://widgets.example.net/logout"
+    redirect_uris = [
+      "https://widgets.example.net/",
+      "https://widgets.example.net/login",
+    ]
 
-  oauth2_allow_implicit_flow = true
+    implicit_grant {
+      access_token_issuance_enabled = true
+    }
+  }
 
   required_resource_access {
-    # Microsoft Graph
-    resource_app_id = "00000003-0000-0000-c000-000000000000"
+    resource_app_id = data.azuread_application_published_app_ids.well_known.result.MicrosoftGraph
 
     resource_access {
-      # User.Read
-      id = "e1fe6dd8-ba31-4d61-89e7-88639da4683d"
+      id   = data.azuread_service_principal.msgraph.oauth2_permission_scope_ids["User.Read"]
       type = "Scope"
     }
   }
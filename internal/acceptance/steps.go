@@ -1,6 +1,11 @@
 package acceptance
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
 
 // ImportStep returns a Test Step which Imports the Resource, optionally
 // ignoring any fields which may not be imported (for example, as they're
@@ -27,3 +32,25 @@ func (td TestData) RequiresImportErrorStep(config string) resource.TestStep {
 		ExpectError: RequiresImportError(td.ResourceType),
 	}
 }
+
+// ImportStepUsingAttribute returns a Test Step which Imports the Resource using the value of
+// the specified attribute as the import ID, for resources which can be imported using a
+// natural key, optionally ignoring any fields which may not be imported
+func (td TestData) ImportStepUsingAttribute(attr string, ignore ...string) resource.TestStep {
+	step := td.ImportStep(ignore...)
+	step.ImportStateIdFunc = func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[td.ResourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %q was not found in state", td.ResourceName)
+		}
+
+		v, ok := rs.Primary.Attributes[attr]
+		if !ok || v == "" {
+			return "", fmt.Errorf("attribute %q was not set for %q", attr, td.ResourceName)
+		}
+
+		return v, nil
+	}
+
+	return step
+}
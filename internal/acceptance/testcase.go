@@ -35,6 +35,18 @@ func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, s
 	td.runAcceptanceTest(t, testCase)
 }
 
+// ResourceTestWithCheckDestroy runs a resource test using the specified function to check the outcome of destroying
+// the resource, for resources which can be configured to be left in place when destroyed
+func (td TestData) ResourceTestWithCheckDestroy(t *testing.T, checkDestroy resource.TestCheckFunc, steps []resource.TestStep) {
+	testCase := resource.TestCase{
+		PreCheck:     func() { PreCheck(t) },
+		CheckDestroy: checkDestroy,
+		Steps:        steps,
+	}
+
+	td.runAcceptanceTest(t, testCase)
+}
+
 func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ProviderFactories = map[string]func() (*schema.Provider, error){
 		"azuread": func() (*schema.Provider, error) {
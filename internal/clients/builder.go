@@ -3,7 +3,10 @@ package clients
 import (
 	"context"
 	"fmt"
+	"log"
 
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/go-azure-helpers/sender"
 
@@ -58,9 +61,25 @@ func (b *ClientBuilder) Build(ctx context.Context) (*Client, error) {
 		return nil, err
 	}
 
+	// Microsoft Graph is only used for features which are not supported by Azure Active Directory Graph, and is
+	// not available in all clouds. Not all authentication methods can obtain a token for it either, so failing to
+	// do so only disables those features, rather than failing the provider configuration
+	var msGraphAuthorizer autorest.Authorizer
+	msGraphEndpoint := msGraphEndpointForEnvironment(*env)
+	if msGraphEndpoint != "" {
+		authorizer, err := b.AuthConfig.GetAuthorizationToken(sender, oauth, msGraphEndpoint)
+		if err != nil {
+			log.Printf("[WARN] Unable to obtain an authorization token for Microsoft Graph, features which require it will not be available: %v", err)
+		} else {
+			msGraphAuthorizer = authorizer
+		}
+	}
+
 	o := &common.ClientOptions{
 		AadGraphAuthorizer: aadGraphAuthorizer,
 		AadGraphEndpoint:   aadGraphEndpoint,
+		MsGraphAuthorizer:  msGraphAuthorizer,
+		MsGraphEndpoint:    msGraphEndpoint,
 		PartnerID:          b.PartnerID,
 		TenantID:           b.AuthConfig.TenantID,
 		TerraformVersion:   b.TerraformVersion,
@@ -73,3 +92,19 @@ func (b *ClientBuilder) Build(ctx context.Context) (*Client, error) {
 
 	return &client, nil
 }
+
+// msGraphEndpointForEnvironment returns the Microsoft Graph endpoint for the specified environment, or an empty string
+// when Microsoft Graph is not known to be available
+func msGraphEndpointForEnvironment(env azure.Environment) string {
+	switch env.Name {
+	case azure.PublicCloud.Name:
+		return "https://graph.microsoft.com/"
+	case azure.USGovernmentCloud.Name:
+		return "https://graph.microsoft.us/"
+	case azure.ChinaCloud.Name:
+		return "https://microsoftgraph.chinacloudapi.cn/"
+	case azure.GermanCloud.Name:
+		return "https://graph.microsoft.de/"
+	}
+	return ""
+}
@@ -2,6 +2,8 @@ package clients
 
 import (
 	"context"
+	"strings"
+	"sync"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -10,6 +12,7 @@ import (
 	applications "github.com/terraform-providers/terraform-provider-azuread/internal/services/applications/client"
 	domains "github.com/terraform-providers/terraform-provider-azuread/internal/services/domains/client"
 	groups "github.com/terraform-providers/terraform-provider-azuread/internal/services/groups/client"
+	policies "github.com/terraform-providers/terraform-provider-azuread/internal/services/policies/client"
 	serviceprincipals "github.com/terraform-providers/terraform-provider-azuread/internal/services/serviceprincipals/client"
 	users "github.com/terraform-providers/terraform-provider-azuread/internal/services/users/client"
 )
@@ -24,11 +27,23 @@ type Client struct {
 
 	AuthenticatedAsAServicePrincipal bool
 
+	// AllowOwnerSelfRemoval permits owner changes which remove the authenticated principal, or all owners
+	AllowOwnerSelfRemoval bool
+
+	// AddCallerAsOwner adds the authenticated principal as an owner of objects created without any owners
+	AddCallerAsOwner bool
+
 	StopContext context.Context
 
+	// templateServicePrincipals records the object IDs of service principals created by instantiating application
+	// templates during this run of the provider, keyed by application ID
+	templateServicePrincipals     map[string]string
+	templateServicePrincipalsLock sync.Mutex
+
 	Applications      *applications.Client
 	Domains           *domains.Client
 	Groups            *groups.Client
+	Policies          *policies.Client
 	ServicePrincipals *serviceprincipals.Client
 	Users             *users.Client
 }
@@ -40,8 +55,31 @@ func (client *Client) build(ctx context.Context, o *common.ClientOptions) error
 	client.Applications = applications.NewClient(o)
 	client.Domains = domains.NewClient(o)
 	client.Groups = groups.NewClient(o)
+	client.Policies = policies.NewClient(o)
 	client.ServicePrincipals = serviceprincipals.NewClient(o)
 	client.Users = users.NewClient(o)
 
 	return nil
 }
+
+// RecordTemplateServicePrincipal records that the service principal with the specified object ID was created for the
+// specified application ID, when instantiating an application template
+func (client *Client) RecordTemplateServicePrincipal(applicationId, objectId string) {
+	client.templateServicePrincipalsLock.Lock()
+	defer client.templateServicePrincipalsLock.Unlock()
+
+	if client.templateServicePrincipals == nil {
+		client.templateServicePrincipals = make(map[string]string)
+	}
+	client.templateServicePrincipals[strings.ToLower(applicationId)] = objectId
+}
+
+// TemplateServicePrincipal returns the object ID of the service principal created for the specified application ID
+// when instantiating an application template during this run of the provider, if any
+func (client *Client) TemplateServicePrincipal(applicationId string) (string, bool) {
+	client.templateServicePrincipalsLock.Lock()
+	defer client.templateServicePrincipalsLock.Unlock()
+
+	objectId, ok := client.templateServicePrincipals[strings.ToLower(applicationId)]
+	return objectId, ok
+}
@@ -24,6 +24,9 @@ type ClientOptions struct {
 	AadGraphAuthorizer autorest.Authorizer
 	AadGraphEndpoint   string
 
+	MsGraphAuthorizer autorest.Authorizer
+	MsGraphEndpoint   string
+
 	SkipProviderReg bool
 }
 
@@ -0,0 +1,109 @@
+package aadgraph
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// AdditionalPropertiesSchema returns the schema for a JSON-encoded object of arbitrary directory object properties,
+// which allows properties that are supported by the API to be specified before they are modelled by the provider
+func AdditionalPropertiesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: structure.SuppressJsonDiff,
+	}
+}
+
+// ExpandAdditionalProperties decodes a JSON-encoded object of additional properties
+func ExpandAdditionalProperties(input string) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	if input == "" {
+		return result, nil
+	}
+
+	if err := json.Unmarshal([]byte(input), &result); err != nil {
+		return nil, fmt.Errorf("additional properties must be a JSON object: %+v", err)
+	}
+
+	return result, nil
+}
+
+// ExpandAdditionalPropertiesChange decodes the new value of a JSON-encoded object of additional properties for
+// updating a directory object. Properties which were present in the old value but have since been removed are
+// included with a null value, so that they are cleared rather than retaining their current values.
+func ExpandAdditionalPropertiesChange(oldInput, newInput string) (map[string]interface{}, error) {
+	oldProperties, err := ExpandAdditionalProperties(oldInput)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := ExpandAdditionalProperties(newInput)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range oldProperties {
+		if _, ok := result[k]; !ok {
+			result[k] = nil
+		}
+	}
+
+	return result, nil
+}
+
+// MergeAdditionalProperties adds additional properties to the properties for a directory object, without replacing
+// any properties which have already been set
+func MergeAdditionalProperties(dest map[string]interface{}, properties map[string]interface{}) {
+	for k, v := range properties {
+		if _, ok := dest[k]; !ok {
+			dest[k] = v
+		}
+	}
+}
+
+// FlattenAdditionalProperties returns a JSON-encoded object containing the current values for the properties of a
+// directory object which are present in the configured additional properties, so that other properties returned by
+// the API do not result in a diff. The object should be retrieved from the API as-is, rather than being converted
+// from an SDK model, since any properties not modelled by the SDK would otherwise be missing.
+func FlattenAdditionalProperties(object map[string]interface{}, configured string) (string, error) {
+	if configured == "" {
+		return "", nil
+	}
+
+	configuredProperties, err := ExpandAdditionalProperties(configured)
+	if err != nil {
+		return "", err
+	}
+
+	result := make(map[string]interface{}, len(configuredProperties))
+	for k := range configuredProperties {
+		result[k] = object[k]
+	}
+
+	flattened, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("marshaling additional properties: %+v", err)
+	}
+
+	return string(flattened), nil
+}
+
+// FlattenStringListProperty returns the values of a multi-valued string property found in the AdditionalProperties
+// of a directory object
+func FlattenStringListProperty(props map[string]interface{}, key string) []interface{} {
+	result := make([]interface{}, 0)
+	if v, ok := props[key].([]interface{}); ok {
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+	}
+	return result
+}
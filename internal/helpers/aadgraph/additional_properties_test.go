@@ -0,0 +1,121 @@
+package aadgraph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandAdditionalPropertiesChange(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Expected map[string]interface{}
+		Error    bool
+	}{
+		{
+			Name:     "added",
+			Old:      "",
+			New:      `{"errorUrl":"https://example.com/error"}`,
+			Expected: map[string]interface{}{"errorUrl": "https://example.com/error"},
+		},
+		{
+			Name:     "changed",
+			Old:      `{"errorUrl":"https://example.com/error"}`,
+			New:      `{"errorUrl":"https://example.com/other"}`,
+			Expected: map[string]interface{}{"errorUrl": "https://example.com/other"},
+		},
+		{
+			Name:     "one removed",
+			Old:      `{"errorUrl":"https://example.com/error","oauth2RequirePostResponse":true}`,
+			New:      `{"oauth2RequirePostResponse":false}`,
+			Expected: map[string]interface{}{"errorUrl": nil, "oauth2RequirePostResponse": false},
+		},
+		{
+			Name:     "all removed",
+			Old:      `{"errorUrl":"https://example.com/error"}`,
+			New:      "",
+			Expected: map[string]interface{}{"errorUrl": nil},
+		},
+		{
+			Name:  "invalid old value",
+			Old:   `["errorUrl"]`,
+			New:   `{"errorUrl":"https://example.com/error"}`,
+			Error: true,
+		},
+		{
+			Name:  "invalid new value",
+			Old:   "",
+			New:   `not json`,
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, err := ExpandAdditionalPropertiesChange(tc.Old, tc.New)
+			if err != nil {
+				if tc.Error {
+					return
+				}
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.Error {
+				t.Fatalf("expected an error")
+			}
+
+			if !reflect.DeepEqual(result, tc.Expected) {
+				t.Fatalf("expected %#v, got %#v", tc.Expected, result)
+			}
+		})
+	}
+}
+
+func TestFlattenAdditionalProperties(t *testing.T) {
+	object := map[string]interface{}{
+		"displayName":               "acctest",
+		"errorUrl":                  "https://example.com/error",
+		"oauth2RequirePostResponse": true,
+		"unmodelledProperty":        []interface{}{"one", "two"},
+	}
+
+	cases := []struct {
+		Name       string
+		Configured string
+		Expected   string
+	}{
+		{
+			Name:       "not configured",
+			Configured: "",
+			Expected:   "",
+		},
+		{
+			Name:       "only configured properties",
+			Configured: `{"oauth2RequirePostResponse":false,"errorUrl":"https://example.com/other"}`,
+			Expected:   `{"errorUrl":"https://example.com/error","oauth2RequirePostResponse":true}`,
+		},
+		{
+			Name:       "property not modelled by the SDK",
+			Configured: `{"unmodelledProperty":[]}`,
+			Expected:   `{"unmodelledProperty":["one","two"]}`,
+		},
+		{
+			Name:       "property missing from object",
+			Configured: `{"missingProperty":"value"}`,
+			Expected:   `{"missingProperty":null}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, err := FlattenAdditionalProperties(object, tc.Configured)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if result != tc.Expected {
+				t.Fatalf("expected %q, got %q", tc.Expected, result)
+			}
+		})
+	}
+}
@@ -118,6 +118,42 @@ func FlattenOauth2Permissions(in *[]graphrbac.OAuth2Permission) []map[string]int
 	return result
 }
 
+// FlattenAppRoleIDs returns a map of app role IDs keyed by the value of each app role, so that roles can be
+// referenced by their value rather than by ID. App roles without a value are omitted.
+func FlattenAppRoleIDs(in *[]graphrbac.AppRole) map[string]interface{} {
+	return flattenIDsByValue(FlattenAppRoles(in))
+}
+
+// FlattenOauth2PermissionScopeIDs returns a map of OAuth2 permission scope IDs keyed by the value of each scope, so
+// that scopes can be referenced by their value rather than by ID. Scopes without a value are omitted.
+func FlattenOauth2PermissionScopeIDs(in *[]graphrbac.OAuth2Permission) map[string]interface{} {
+	return flattenIDsByValue(FlattenOauth2Permissions(in))
+}
+
+func flattenIDsByValue(in []map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for _, item := range in {
+		value := flattenedString(item["value"])
+		if value == "" {
+			continue
+		}
+		result[value] = flattenedString(item["id"])
+	}
+	return result
+}
+
+func flattenedString(in interface{}) string {
+	switch v := in.(type) {
+	case string:
+		return v
+	case *string:
+		if v != nil {
+			return *v
+		}
+	}
+	return ""
+}
+
 func ApplicationAllOwners(ctx context.Context, client *graphrbac.ApplicationsClient, appId string) ([]string, error) {
 	owners, err := client.ListOwnersComplete(ctx, appId)
 
@@ -362,6 +398,83 @@ func AppRolesSet(ctx context.Context, client *graphrbac.ApplicationsClient, appI
 	return nil
 }
 
+// AppRolesSetDeclared sets the specified App Roles for an application whilst preserving any other existing App Roles,
+// so that these can be managed elsewhere. Existing roles matching a declared role by ID or value are replaced, and
+// existing roles with an ID in removeIds are removed.
+func AppRolesSetDeclared(ctx context.Context, client *graphrbac.ApplicationsClient, appId string, declaredRoles *[]graphrbac.AppRole, removeIds []string) error {
+	if declaredRoles == nil {
+		return fmt.Errorf("cannot set nil App Roles for Application with ID %q", appId)
+	}
+
+	app, err := client.Get(ctx, appId)
+	if err != nil {
+		if utils.ResponseWasNotFound(app.Response) {
+			return fmt.Errorf("application with ID %q was not found", appId)
+		}
+
+		return fmt.Errorf("retrieving Application with ID %q: %+v", appId, err)
+	}
+
+	managed := make(map[string]bool)
+	for _, id := range removeIds {
+		managed[id] = true
+	}
+	for _, role := range *declaredRoles {
+		if role.ID != nil {
+			managed[*role.ID] = true
+		}
+		if role.Value != nil && *role.Value != "" {
+			managed["value:"+*role.Value] = true
+		}
+	}
+
+	isManaged := func(role graphrbac.AppRole) bool {
+		return (role.ID != nil && managed[*role.ID]) || (role.Value != nil && *role.Value != "" && managed["value:"+*role.Value])
+	}
+
+	newRoles := make([]graphrbac.AppRole, 0)
+	disabledRoles := make([]graphrbac.AppRole, 0)
+	anyManaged := false
+	if app.AppRoles != nil {
+		for _, role := range *app.AppRoles {
+			if isManaged(role) {
+				anyManaged = true
+				disabled := role
+				disabled.IsEnabled = utils.Bool(false)
+				disabledRoles = append(disabledRoles, disabled)
+				continue
+			}
+			newRoles = append(newRoles, role)
+			disabledRoles = append(disabledRoles, role)
+		}
+	}
+	newRoles = append(newRoles, *declaredRoles...)
+
+	// don't update if no changes to be made
+	if app.AppRoles != nil && reflect.DeepEqual(*app.AppRoles, newRoles) {
+		return nil
+	}
+
+	// roles must be disabled before they can be edited or removed, but only those being managed are disabled here
+	if anyManaged {
+		properties := graphrbac.ApplicationUpdateParameters{
+			AppRoles: &disabledRoles,
+		}
+		if _, err := client.Patch(ctx, appId, properties); err != nil {
+			return fmt.Errorf("disabling App Roles for Application with ID %q: %+v", appId, err)
+		}
+	}
+
+	properties := graphrbac.ApplicationUpdateParameters{
+		AppRoles: &newRoles,
+	}
+	if _, err := client.Patch(ctx, appId, properties); err != nil {
+		return fmt.Errorf("setting App Roles for Application with ID %q: %+v", appId, err)
+	}
+
+	return nil
+}
+
 func OAuth2PermissionFindById(app graphrbac.Application, permissionId string) (*graphrbac.OAuth2Permission, error) {
 	if app.Oauth2Permissions == nil {
 		return nil, nil
@@ -527,3 +640,168 @@ func OAuth2PermissionsSet(ctx context.Context, client *graphrbac.ApplicationsCli
 
 	return nil
 }
+
+// OAuth2PermissionsSetDeclared sets the specified OAuth2 Permissions for an application whilst preserving any other
+// existing OAuth2 Permissions, so that these can be managed elsewhere. Existing permissions matching a declared
+// permission by ID or value are replaced, and existing permissions with an ID in removeIds are removed.
+func OAuth2PermissionsSetDeclared(ctx context.Context, client *graphrbac.ApplicationsClient, appId string, declaredPermissions *[]graphrbac.OAuth2Permission, removeIds []string) error {
+	if declaredPermissions == nil {
+		return fmt.Errorf("cannot set nil OAuth2 Permissions for Application with ID %q", appId)
+	}
+
+	app, err := client.Get(ctx, appId)
+	if err != nil {
+		if utils.ResponseWasNotFound(app.Response) {
+			return fmt.Errorf("application with ID %q was not found", appId)
+		}
+
+		return fmt.Errorf("retrieving Application with ID %q: %+v", appId, err)
+	}
+
+	managed := make(map[string]bool)
+	for _, id := range removeIds {
+		managed[id] = true
+	}
+	for _, permission := range *declaredPermissions {
+		if permission.ID != nil {
+			managed[*permission.ID] = true
+		}
+		if permission.Value != nil && *permission.Value != "" {
+			managed["value:"+*permission.Value] = true
+		}
+	}
+
+	isManaged := func(permission graphrbac.OAuth2Permission) bool {
+		return (permission.ID != nil && managed[*permission.ID]) || (permission.Value != nil && *permission.Value != "" && managed["value:"+*permission.Value])
+	}
+
+	newPermissions := make([]graphrbac.OAuth2Permission, 0)
+	disabledPermissions := make([]graphrbac.OAuth2Permission, 0)
+	anyManaged := false
+	if app.Oauth2Permissions != nil {
+		for _, permission := range *app.Oauth2Permissions {
+			if isManaged(permission) {
+				anyManaged = true
+				disabled := permission
+				disabled.IsEnabled = utils.Bool(false)
+				disabledPermissions = append(disabledPermissions, disabled)
+				continue
+			}
+			newPermissions = append(newPermissions, permission)
+			disabledPermissions = append(disabledPermissions, permission)
+		}
+	}
+	newPermissions = append(newPermissions, *declaredPermissions...)
+
+	// don't update if no changes to be made
+	if app.Oauth2Permissions != nil && reflect.DeepEqual(*app.Oauth2Permissions, newPermissions) {
+		return nil
+	}
+
+	// permissions must be disabled before they can be edited or removed, but only those being managed are disabled here
+	if anyManaged {
+		properties := graphrbac.ApplicationUpdateParameters{
+			Oauth2Permissions: &disabledPermissions,
+		}
+		if _, err := client.Patch(ctx, appId, properties); err != nil {
+			return fmt.Errorf("disabling OAuth2 Permissions for Application with ID %q: %+v", appId, err)
+		}
+	}
+
+	properties := graphrbac.ApplicationUpdateParameters{
+		Oauth2Permissions: &newPermissions,
+	}
+	if _, err := client.Patch(ctx, appId, properties); err != nil {
+		return fmt.Errorf("setting OAuth2 Permissions for Application with ID %q: %+v", appId, err)
+	}
+
+	return nil
+}
+
+func PreAuthorizedApplicationFindByAppId(app graphrbac.Application, appId string) (*graphrbac.PreAuthorizedApplication, error) {
+	if app.PreAuthorizedApplications == nil {
+		return nil, nil
+	}
+
+	if appId == "" {
+		return nil, errors.New("specified app ID is blank")
+	}
+
+	for _, p := range *app.PreAuthorizedApplications {
+		if p.AppID == nil {
+			continue
+		}
+		if *p.AppID == appId {
+			return &p, nil
+		}
+	}
+	return nil, nil
+}
+
+func PreAuthorizedApplicationAdd(existing *[]graphrbac.PreAuthorizedApplication, preAuthorized *graphrbac.PreAuthorizedApplication) (*[]graphrbac.PreAuthorizedApplication, error) {
+	if preAuthorized == nil {
+		return nil, errors.New("pre-authorized application to be added is null")
+	} else if preAuthorized.AppID == nil {
+		return nil, errors.New("app ID of new pre-authorized application is null")
+	}
+
+	cap := 1
+	if existing != nil {
+		cap += len(*existing)
+	}
+
+	newPreAuthorized := make([]graphrbac.PreAuthorizedApplication, 1, cap)
+	newPreAuthorized[0] = *preAuthorized
+
+	if existing != nil {
+		for _, v := range *existing {
+			if v.AppID != nil && *v.AppID == *preAuthorized.AppID {
+				return nil, &AlreadyExistsError{"Pre-Authorized Application", *preAuthorized.AppID}
+			}
+			newPreAuthorized = append(newPreAuthorized, v)
+		}
+	}
+
+	return &newPreAuthorized, nil
+}
+
+func PreAuthorizedApplicationUpdate(existing *[]graphrbac.PreAuthorizedApplication, preAuthorized *graphrbac.PreAuthorizedApplication) (*[]graphrbac.PreAuthorizedApplication, error) {
+	if preAuthorized == nil {
+		return nil, errors.New("pre-authorized application to be updated is null")
+	} else if preAuthorized.AppID == nil {
+		return nil, errors.New("app ID of pre-authorized application to be updated is null")
+	} else if existing == nil {
+		return nil, errors.New("pre-authorized applications cannot be null when updating")
+	}
+
+	newPreAuthorized := make([]graphrbac.PreAuthorizedApplication, len(*existing))
+
+	for i, v := range *existing {
+		if v.AppID != nil && *v.AppID == *preAuthorized.AppID {
+			newPreAuthorized[i] = *preAuthorized
+			continue
+		}
+		newPreAuthorized[i] = v
+	}
+
+	return &newPreAuthorized, nil
+}
+
+func PreAuthorizedApplicationResultRemoveByAppId(existing *[]graphrbac.PreAuthorizedApplication, appId string) (*[]graphrbac.PreAuthorizedApplication, error) {
+	if existing == nil {
+		return nil, errors.New("existing pre-authorized applications are null")
+	} else if appId == "" {
+		return nil, errors.New("app ID of pre-authorized application to be removed is empty")
+	}
+
+	newPreAuthorized := make([]graphrbac.PreAuthorizedApplication, 0)
+
+	for _, v := range *existing {
+		if v.AppID != nil && *v.AppID == appId {
+			continue
+		}
+		newPreAuthorized = append(newPreAuthorized, v)
+	}
+
+	return &newPreAuthorized, nil
+}
@@ -0,0 +1,106 @@
+package aadgraph
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// ApplicationLogoGet retrieves the main logo for an application. When the application has no logo, the returned
+// content will be empty and the response will have a 404 status.
+func ApplicationLogoGet(ctx context.Context, client *graphrbac.ApplicationsClient, objectId string) (content []byte, result autorest.Response, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{tenantID}/applications/{objectId}/mainLogo", applicationLogoPathParameters(client, objectId)),
+		autorest.WithQueryParameters(applicationLogoQueryParameters())).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	result.Response = resp
+	if err != nil {
+		return nil, result, fmt.Errorf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if err = autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK)); err != nil {
+		return nil, result, fmt.Errorf("retrieving logo for Application with ID %q: %+v", objectId, err)
+	}
+
+	content, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, result, fmt.Errorf("reading logo for Application with ID %q: %+v", objectId, err)
+	}
+
+	return content, result, nil
+}
+
+// ApplicationLogoSet uploads the main logo for an application, replacing any existing logo.
+func ApplicationLogoSet(ctx context.Context, client *graphrbac.ApplicationsClient, objectId string, contentType string, content []byte) (result autorest.Response, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType(contentType),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{tenantID}/applications/{objectId}/mainLogo", applicationLogoPathParameters(client, objectId)),
+		autorest.WithBytes(&content),
+		autorest.WithQueryParameters(applicationLogoQueryParameters())).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	result.Response = resp
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	if err = autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent), autorest.ByClosing()); err != nil {
+		return result, fmt.Errorf("uploading logo for Application with ID %q: %+v", objectId, err)
+	}
+
+	return result, nil
+}
+
+// ApplicationLogoDelete removes the main logo from an application.
+func ApplicationLogoDelete(ctx context.Context, client *graphrbac.ApplicationsClient, objectId string) (result autorest.Response, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{tenantID}/applications/{objectId}/mainLogo", applicationLogoPathParameters(client, objectId)),
+		autorest.WithQueryParameters(applicationLogoQueryParameters())).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	result.Response = resp
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	if err = autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent), autorest.ByClosing()); err != nil {
+		return result, fmt.Errorf("removing logo for Application with ID %q: %+v", objectId, err)
+	}
+
+	return result, nil
+}
+
+func applicationLogoPathParameters(client *graphrbac.ApplicationsClient, objectId string) map[string]interface{} {
+	return map[string]interface{}{
+		"objectId": autorest.Encode("path", objectId),
+		"tenantID": autorest.Encode("path", client.TenantID),
+	}
+}
+
+func applicationLogoQueryParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"api-version": "1.6",
+	}
+}
@@ -478,3 +478,96 @@ func WaitForKeyCredentialReplication(ctx context.Context, keyId string, timeout
 		},
 	}).WaitForStateContext(ctx)
 }
+
+// KeyCredentialResultFindByCustomKeyIdentifier returns the key credential having the specified custom key identifier
+// and usage. Token signing certificates are represented by a `Sign` and a `Verify` key credential, which share the
+// same custom key identifier.
+func KeyCredentialResultFindByCustomKeyIdentifier(creds graphrbac.KeyCredentialListResult, customKeyIdentifier, usage string) *graphrbac.KeyCredential {
+	if creds.Value != nil {
+		for _, c := range *creds.Value {
+			if c.CustomKeyIdentifier == nil || c.Usage == nil {
+				continue
+			}
+			if *c.CustomKeyIdentifier == customKeyIdentifier && strings.EqualFold(*c.Usage, usage) {
+				return &c
+			}
+		}
+	}
+
+	return nil
+}
+
+// KeyCredentialResultRemoveByCustomKeyIdentifier removes all key credentials having the specified custom key identifier
+func KeyCredentialResultRemoveByCustomKeyIdentifier(existing graphrbac.KeyCredentialListResult, customKeyIdentifier string) (*[]graphrbac.KeyCredential, error) {
+	if customKeyIdentifier == "" {
+		return nil, errors.New("custom identifier of keys to be removed is blank")
+	}
+
+	newCreds := make([]graphrbac.KeyCredential, 0)
+
+	if existing.Value != nil {
+		for _, v := range *existing.Value {
+			if v.CustomKeyIdentifier != nil && *v.CustomKeyIdentifier == customKeyIdentifier {
+				continue
+			}
+
+			newCreds = append(newCreds, v)
+		}
+	}
+
+	return &newCreds, nil
+}
+
+// PasswordCredentialResultRemoveByCustomKeyIdentifier removes all password credentials having the specified custom
+// key identifier, which should be base64 encoded as it is for key credentials
+func PasswordCredentialResultRemoveByCustomKeyIdentifier(existing graphrbac.PasswordCredentialListResult, customKeyIdentifier string) (*[]graphrbac.PasswordCredential, error) {
+	if customKeyIdentifier == "" {
+		return nil, errors.New("custom identifier of passwords to be removed is blank")
+	}
+
+	newCreds := make([]graphrbac.PasswordCredential, 0)
+
+	if existing.Value != nil {
+		for _, v := range *existing.Value {
+			if v.CustomKeyIdentifier != nil && base64.StdEncoding.EncodeToString(*v.CustomKeyIdentifier) == customKeyIdentifier {
+				continue
+			}
+
+			newCreds = append(newCreds, v)
+		}
+	}
+
+	return &newCreds, nil
+}
+
+// WaitForSigningKeyCredentialReplication waits for the `Sign` key credential having the specified custom key
+// identifier to become available, and returns it
+func WaitForSigningKeyCredentialReplication(ctx context.Context, customKeyIdentifier string, timeout time.Duration, f func() (graphrbac.KeyCredentialListResult, error)) (*graphrbac.KeyCredential, error) {
+	var credential *graphrbac.KeyCredential
+
+	_, err := (&resource.StateChangeConf{
+		Pending:                   []string{"NotFound"},
+		Target:                    []string{"Found"},
+		Timeout:                   timeout,
+		MinTimeout:                1 * time.Second,
+		ContinuousTargetOccurence: 10,
+		Refresh: func() (interface{}, string, error) {
+			creds, err := f()
+			if err != nil {
+				if utils.ResponseWasNotFound(creds.Response) {
+					return creds, "NotFound", nil
+				}
+				return creds, "Error", fmt.Errorf("unable to retrieve object, received response with status %d: %v", creds.Response.StatusCode, err)
+			}
+
+			credential = KeyCredentialResultFindByCustomKeyIdentifier(creds, customKeyIdentifier, "Sign")
+			if credential == nil {
+				return creds, "NotFound", nil
+			}
+
+			return creds, "Found", nil
+		},
+	}).WaitForStateContext(ctx)
+
+	return credential, err
+}
@@ -0,0 +1,229 @@
+package aadgraph
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+// credentialsUpdateAttempts is the number of times a credential list update is attempted when a concurrent
+// modification is detected
+const credentialsUpdateAttempts = 3
+
+// credentialsVerifyTimeout is the longest time to wait for an updated credential list to be observed, before assuming
+// that it was overwritten by a concurrent update
+const credentialsVerifyTimeout = 2 * time.Minute
+
+// PasswordCredentialsClient is implemented by the clients for applications and service principals
+type PasswordCredentialsClient interface {
+	ListPasswordCredentials(ctx context.Context, objectID string) (graphrbac.PasswordCredentialListResult, error)
+	UpdatePasswordCredentials(ctx context.Context, objectID string, parameters graphrbac.PasswordCredentialsUpdateParameters) (autorest.Response, error)
+}
+
+// KeyCredentialsClient is implemented by the clients for applications and service principals
+type KeyCredentialsClient interface {
+	ListKeyCredentials(ctx context.Context, objectID string) (graphrbac.KeyCredentialListResult, error)
+	UpdateKeyCredentials(ctx context.Context, objectID string, parameters graphrbac.KeyCredentialsUpdateParameters) (autorest.Response, error)
+}
+
+// PasswordCredentialsUpdate replaces the password credentials for an application or service principal with the list
+// returned by `modify`, which is called with the current credentials. Since the API only supports replacing the
+// entire list, a concurrent update by another client could otherwise silently remove credentials. Therefore the
+// update is retried if the list changes before it can be written, and the resulting list is verified to contain
+// every credential which was not intentionally removed. An error is returned if this cannot be achieved.
+func PasswordCredentialsUpdate(ctx context.Context, client PasswordCredentialsClient, objectId string, timeout time.Duration, modify func(existing graphrbac.PasswordCredentialListResult) (*[]graphrbac.PasswordCredential, error)) error {
+	listKeyIds := func() ([]string, error) {
+		existing, err := client.ListPasswordCredentials(ctx, objectId)
+		if err != nil {
+			return nil, fmt.Errorf("listing password credentials: %+v", err)
+		}
+		return passwordCredentialKeyIds(existing.Value), nil
+	}
+
+	prepare := func(attempt int) ([]string, []string, func() error, error) {
+		existing, err := client.ListPasswordCredentials(ctx, objectId)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("listing password credentials: %+v", err)
+		}
+		before := passwordCredentialKeyIds(existing.Value)
+
+		newCreds, err := modify(existing)
+		if err != nil {
+			// a credential being added on a previous attempt may have been written after all
+			if _, ok := err.(*AlreadyExistsError); ok && attempt > 1 {
+				return before, before, nil, nil
+			}
+			return nil, nil, nil, err
+		}
+
+		write := func() error {
+			if _, err := client.UpdatePasswordCredentials(ctx, objectId, graphrbac.PasswordCredentialsUpdateParameters{Value: newCreds}); err != nil {
+				return fmt.Errorf("updating password credentials: %+v", err)
+			}
+			return nil
+		}
+
+		return before, passwordCredentialKeyIds(newCreds), write, nil
+	}
+
+	return credentialsUpdateVerified(ctx, "password credentials", objectId, timeout, listKeyIds, prepare)
+}
+
+// KeyCredentialsUpdate replaces the key credentials for an application or service principal with the list returned by
+// `modify`, which is called with the current credentials. Concurrent modifications are handled in the same way as for
+// PasswordCredentialsUpdate.
+func KeyCredentialsUpdate(ctx context.Context, client KeyCredentialsClient, objectId string, timeout time.Duration, modify func(existing graphrbac.KeyCredentialListResult) (*[]graphrbac.KeyCredential, error)) error {
+	listKeyIds := func() ([]string, error) {
+		existing, err := client.ListKeyCredentials(ctx, objectId)
+		if err != nil {
+			return nil, fmt.Errorf("listing key credentials: %+v", err)
+		}
+		return keyCredentialKeyIds(existing.Value), nil
+	}
+
+	prepare := func(attempt int) ([]string, []string, func() error, error) {
+		existing, err := client.ListKeyCredentials(ctx, objectId)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("listing key credentials: %+v", err)
+		}
+		before := keyCredentialKeyIds(existing.Value)
+
+		newCreds, err := modify(existing)
+		if err != nil {
+			// a credential being added on a previous attempt may have been written after all
+			if _, ok := err.(*AlreadyExistsError); ok && attempt > 1 {
+				return before, before, nil, nil
+			}
+			return nil, nil, nil, err
+		}
+
+		write := func() error {
+			if _, err := client.UpdateKeyCredentials(ctx, objectId, graphrbac.KeyCredentialsUpdateParameters{Value: newCreds}); err != nil {
+				return fmt.Errorf("updating key credentials: %+v", err)
+			}
+			return nil
+		}
+
+		return before, keyCredentialKeyIds(newCreds), write, nil
+	}
+
+	return credentialsUpdateVerified(ctx, "key credentials", objectId, timeout, listKeyIds, prepare)
+}
+
+// credentialsUpdateVerified performs a read-modify-write of a credential list, in terms of key IDs so that it can be
+// used for both password and key credentials. `prepare` lists the current credentials and computes the new list,
+// returning the key IDs before and after the change, along with a function to write the new list.
+func credentialsUpdateVerified(ctx context.Context, description, objectId string, timeout time.Duration, listKeyIds func() ([]string, error), prepare func(attempt int) (before, desired []string, write func() error, err error)) error {
+	var conflictErr error
+
+	for attempt := 1; attempt <= credentialsUpdateAttempts; attempt++ {
+		before, desired, write, err := prepare(attempt)
+		if err != nil {
+			return err
+		}
+		removed := utils.Difference(before, desired)
+
+		// list the credentials again immediately before writing, so that any added in the meantime are not removed
+		current, err := listKeyIds()
+		if err != nil {
+			return err
+		}
+		if foreign := utils.Difference(utils.Difference(current, desired), removed); len(foreign) > 0 {
+			conflictErr = fmt.Errorf("%s for object with ID %q were modified concurrently, and writing them would remove credentials with key IDs: %s", description, objectId, strings.Join(foreign, ", "))
+			log.Printf("[DEBUG] %+v - retrying (attempt %d of %d)", conflictErr, attempt, credentialsUpdateAttempts)
+			continue
+		}
+
+		if write != nil {
+			if err := write(); err != nil {
+				return err
+			}
+		}
+
+		verifyTimeout := timeout
+		if verifyTimeout > credentialsVerifyTimeout {
+			verifyTimeout = credentialsVerifyTimeout
+		}
+
+		missing, err := credentialsWaitForKeyIds(ctx, verifyTimeout, listKeyIds, desired, removed)
+		if err != nil {
+			return err
+		}
+		if len(missing) == 0 {
+			return nil
+		}
+
+		conflictErr = fmt.Errorf("%s for object with ID %q were modified concurrently, and credentials with the following key IDs were lost or reinstated: %s", description, objectId, strings.Join(missing, ", "))
+		log.Printf("[DEBUG] %+v - retrying (attempt %d of %d)", conflictErr, attempt, credentialsUpdateAttempts)
+	}
+
+	return fmt.Errorf("giving up after %d attempts: %+v", credentialsUpdateAttempts, conflictErr)
+}
+
+// credentialsWaitForKeyIds waits for the credential list to contain all the `present` key IDs and none of the `absent`
+// key IDs, allowing for replication delay. If this does not happen before the timeout, the key IDs which do not match
+// are returned.
+func credentialsWaitForKeyIds(ctx context.Context, timeout time.Duration, listKeyIds func() ([]string, error), present, absent []string) ([]string, error) {
+	var mismatched []string
+
+	_, err := (&resource.StateChangeConf{
+		Pending:    []string{"Waiting"},
+		Target:     []string{"Verified"},
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			current, err := listKeyIds()
+			if err != nil {
+				return nil, "Error", err
+			}
+
+			mismatched = append(utils.Difference(present, current), utils.Difference(absent, utils.Difference(absent, current))...)
+			if len(mismatched) > 0 {
+				return current, "Waiting", nil
+			}
+
+			return current, "Verified", nil
+		},
+	}).WaitForStateContext(ctx)
+
+	if err != nil {
+		if _, ok := err.(*resource.TimeoutError); ok {
+			return mismatched, nil
+		}
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func passwordCredentialKeyIds(creds *[]graphrbac.PasswordCredential) []string {
+	keyIds := make([]string, 0)
+	if creds != nil {
+		for _, c := range *creds {
+			if c.KeyID != nil {
+				keyIds = append(keyIds, *c.KeyID)
+			}
+		}
+	}
+	return keyIds
+}
+
+func keyCredentialKeyIds(creds *[]graphrbac.KeyCredential) []string {
+	keyIds := make([]string, 0)
+	if creds != nil {
+		for _, c := range *creds {
+			if c.KeyID != nil {
+				keyIds = append(keyIds, *c.KeyID)
+			}
+		}
+	}
+	return keyIds
+}
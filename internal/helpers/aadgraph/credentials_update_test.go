@@ -0,0 +1,185 @@
+package aadgraph
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeCredentials simulates the list of credential key IDs for an object, along with other clients modifying it
+type fakeCredentials struct {
+	keys []string
+
+	// staleKeys, when set, is returned when the credentials are listed to prepare an update, simulating a read from a
+	// replica which has not yet observed the latest changes
+	staleKeys []string
+
+	writes int
+}
+
+func (f *fakeCredentials) listKeyIds() ([]string, error) {
+	return append([]string{}, f.keys...), nil
+}
+
+func (f *fakeCredentials) prepare(modify func([]string) []string, beforeWrite, afterWrite func(f *fakeCredentials, attempt int)) func(int) ([]string, []string, func() error, error) {
+	return func(attempt int) ([]string, []string, func() error, error) {
+		before := append([]string{}, f.keys...)
+		if f.staleKeys != nil {
+			before = append([]string{}, f.staleKeys...)
+		}
+		desired := modify(append([]string{}, before...))
+
+		write := func() error {
+			f.writes++
+			f.keys = append([]string{}, desired...)
+			if afterWrite != nil {
+				afterWrite(f, attempt)
+			}
+			return nil
+		}
+
+		if beforeWrite != nil {
+			beforeWrite(f, attempt)
+		}
+
+		return before, desired, write, nil
+	}
+}
+
+func credentialsAdd(keyId string) func([]string) []string {
+	return func(keys []string) []string {
+		return append(keys, keyId)
+	}
+}
+
+func credentialsRemove(keyId string) func([]string) []string {
+	return func(keys []string) []string {
+		result := make([]string, 0)
+		for _, k := range keys {
+			if k != keyId {
+				result = append(result, k)
+			}
+		}
+		return result
+	}
+}
+
+func TestCredentialsUpdateVerified(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Initial     []string
+		Stale       []string
+		Modify      func([]string) []string
+		BeforeWrite func(f *fakeCredentials, attempt int)
+		AfterWrite  func(f *fakeCredentials, attempt int)
+		Expected    []string
+		Writes      int
+		Error       string
+	}{
+		{
+			Name:     "clean add",
+			Initial:  []string{"existing"},
+			Modify:   credentialsAdd("added"),
+			Expected: []string{"added", "existing"},
+			Writes:   1,
+		},
+		{
+			Name:     "clean remove",
+			Initial:  []string{"existing", "removed"},
+			Modify:   credentialsRemove("removed"),
+			Expected: []string{"existing"},
+			Writes:   1,
+		},
+		{
+			Name:    "concurrent foreign add is preserved",
+			Initial: []string{"existing"},
+			Modify:  credentialsAdd("added"),
+			BeforeWrite: func(f *fakeCredentials, attempt int) {
+				if attempt == 1 {
+					f.keys = append(f.keys, "foreign")
+				}
+			},
+			Expected: []string{"added", "existing", "foreign"},
+			Writes:   1,
+		},
+		{
+			Name:    "lost update is retried",
+			Initial: []string{"existing"},
+			Modify:  credentialsAdd("added"),
+			AfterWrite: func(f *fakeCredentials, attempt int) {
+				if attempt == 1 {
+					// another client writes its own stale list, dropping the added credential
+					f.keys = []string{"existing", "foreign"}
+				}
+			},
+			Expected: []string{"added", "existing", "foreign"},
+			Writes:   2,
+		},
+		{
+			Name:    "reinstated credential is retried",
+			Initial: []string{"existing", "removed"},
+			Modify:  credentialsRemove("removed"),
+			AfterWrite: func(f *fakeCredentials, attempt int) {
+				if attempt == 1 {
+					f.keys = []string{"existing", "removed"}
+				}
+			},
+			Expected: []string{"existing"},
+			Writes:   2,
+		},
+		{
+			Name:    "retries are exhausted",
+			Initial: []string{"existing"},
+			Modify:  credentialsAdd("added"),
+			AfterWrite: func(f *fakeCredentials, attempt int) {
+				f.keys = []string{"existing"}
+			},
+			Expected: []string{"existing"},
+			Writes:   credentialsUpdateAttempts,
+			Error:    "were lost or reinstated: added",
+		},
+		{
+			Name:     "foreign credential would be removed",
+			Initial:  []string{"existing", "foreign"},
+			Stale:    []string{"existing"},
+			Modify:   credentialsAdd("added"),
+			Expected: []string{"existing", "foreign"},
+			Writes:   0,
+			Error:    "would remove credentials with key IDs: foreign",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			f := &fakeCredentials{
+				keys:      tc.Initial,
+				staleKeys: tc.Stale,
+			}
+
+			err := credentialsUpdateVerified(context.Background(), "password credentials", "00000000-0000-0000-0000-000000000000", 1*time.Second, f.listKeyIds, f.prepare(tc.Modify, tc.BeforeWrite, tc.AfterWrite))
+			if tc.Error == "" && err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.Error != "" {
+				if err == nil {
+					t.Fatalf("expected an error containing %q", tc.Error)
+				}
+				if !strings.Contains(err.Error(), tc.Error) {
+					t.Fatalf("expected an error containing %q, got: %+v", tc.Error, err)
+				}
+			}
+
+			if f.writes != tc.Writes {
+				t.Fatalf("expected %d writes, got %d", tc.Writes, f.writes)
+			}
+
+			sort.Strings(f.keys)
+			if !reflect.DeepEqual(f.keys, tc.Expected) {
+				t.Fatalf("expected key IDs %v, got %v", tc.Expected, f.keys)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package aadgraph
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+)
+
+// DomainFindVerified returns the verified domain with the specified name, or nil if there is no such domain
+func DomainFindVerified(domains []graphrbac.Domain, name string) *graphrbac.Domain {
+	for _, domain := range domains {
+		if domain.Name != nil && strings.EqualFold(*domain.Name, name) && domain.IsVerified != nil && *domain.IsVerified {
+			return &domain
+		}
+	}
+	return nil
+}
+
+// DomainFindVerifiedForHost returns the verified domain which matches the specified host name, either exactly or as a
+// parent domain of the host, or nil if there is no such domain
+func DomainFindVerifiedForHost(domains []graphrbac.Domain, host string) *graphrbac.Domain {
+	for name := host; name != ""; {
+		if domain := DomainFindVerified(domains, name); domain != nil {
+			return domain
+		}
+		i := strings.Index(name, ".")
+		if i < 0 {
+			break
+		}
+		name = name[i+1:]
+	}
+	return nil
+}
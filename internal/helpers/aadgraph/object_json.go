@@ -0,0 +1,143 @@
+package aadgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// properties of directory objects which contain secrets, and which are redacted from object JSON
+var objectJSONRedactedProperties = []string{
+	"passwordProfile",
+}
+
+// properties of directory objects containing credentials, for which the `value` of each credential is redacted from
+// object JSON
+var objectJSONCredentialProperties = []string{
+	"keyCredentials",
+	"passwordCredentials",
+}
+
+// ApplicationObjectJSON retrieves the complete application object as canonical JSON, with credentials redacted
+func ApplicationObjectJSON(ctx context.Context, client *graphrbac.ApplicationsClient, objectId string) (string, error) {
+	return directoryObjectJSON(ctx, client.BaseClient, "applications", objectId)
+}
+
+// GroupObjectJSON retrieves the complete group object as canonical JSON
+func GroupObjectJSON(ctx context.Context, client *graphrbac.GroupsClient, objectId string) (string, error) {
+	return directoryObjectJSON(ctx, client.BaseClient, "groups", objectId)
+}
+
+// ServicePrincipalObjectJSON retrieves the complete service principal object as canonical JSON, with credentials
+// redacted
+func ServicePrincipalObjectJSON(ctx context.Context, client *graphrbac.ServicePrincipalsClient, objectId string) (string, error) {
+	return directoryObjectJSON(ctx, client.BaseClient, "servicePrincipals", objectId)
+}
+
+// UserObjectJSON retrieves the complete user object as canonical JSON, with the password profile redacted
+func UserObjectJSON(ctx context.Context, client *graphrbac.UsersClient, objectId string) (string, error) {
+	return directoryObjectJSON(ctx, client.BaseClient, "users", objectId)
+}
+
+// ApplicationObjectProperties retrieves the complete application object as returned by the API, including any
+// properties which are not modelled by the SDK
+func ApplicationObjectProperties(ctx context.Context, client *graphrbac.ApplicationsClient, objectId string) (map[string]interface{}, error) {
+	return directoryObjectProperties(ctx, client.BaseClient, "applications", objectId)
+}
+
+// GroupObjectProperties retrieves the complete group object as returned by the API, including any properties which
+// are not modelled by the SDK
+func GroupObjectProperties(ctx context.Context, client *graphrbac.GroupsClient, objectId string) (map[string]interface{}, error) {
+	return directoryObjectProperties(ctx, client.BaseClient, "groups", objectId)
+}
+
+// ServicePrincipalObjectProperties retrieves the complete service principal object as returned by the API, including
+// any properties which are not modelled by the SDK
+func ServicePrincipalObjectProperties(ctx context.Context, client *graphrbac.ServicePrincipalsClient, objectId string) (map[string]interface{}, error) {
+	return directoryObjectProperties(ctx, client.BaseClient, "servicePrincipals", objectId)
+}
+
+// UserObjectProperties retrieves the complete user object as returned by the API, including any properties which are
+// not modelled by the SDK
+func UserObjectProperties(ctx context.Context, client *graphrbac.UsersClient, objectId string) (map[string]interface{}, error) {
+	return directoryObjectProperties(ctx, client.BaseClient, "users", objectId)
+}
+
+func directoryObjectJSON(ctx context.Context, client graphrbac.BaseClient, collection, objectId string) (string, error) {
+	object, err := directoryObjectProperties(ctx, client, collection, objectId)
+	if err != nil {
+		return "", err
+	}
+
+	return CanonicalObjectJSON(object)
+}
+
+func directoryObjectProperties(ctx context.Context, client graphrbac.BaseClient, collection, objectId string) (map[string]interface{}, error) {
+	pathParameters := map[string]interface{}{
+		"collection": collection,
+		"objectId":   autorest.Encode("path", objectId),
+		"tenantID":   autorest.Encode("path", client.TenantID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": "1.6",
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{tenantID}/{collection}/{objectId}", pathParameters),
+		autorest.WithQueryParameters(queryParameters)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return nil, fmt.Errorf("sending request: %+v", err)
+	}
+
+	object := make(map[string]interface{})
+	if err := autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK), autorest.ByUnmarshallingJSON(&object), autorest.ByClosing()); err != nil {
+		return nil, fmt.Errorf("retrieving %s object with ID %q: %+v", collection, objectId, err)
+	}
+
+	return object, nil
+}
+
+// CanonicalObjectJSON encodes a directory object as JSON with stable key ordering, omitting OData metadata and
+// redacting any secrets
+func CanonicalObjectJSON(object map[string]interface{}) (string, error) {
+	delete(object, "odata.metadata")
+
+	for _, property := range objectJSONRedactedProperties {
+		if _, ok := object[property]; ok {
+			object[property] = nil
+		}
+	}
+
+	for _, property := range objectJSONCredentialProperties {
+		credentials, ok := object[property].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, raw := range credentials {
+			if credential, ok := raw.(map[string]interface{}); ok {
+				credential["value"] = nil
+			}
+		}
+	}
+
+	// map keys are sorted when encoding, which results in stable key ordering at every level
+	result, err := json.Marshal(object)
+	if err != nil {
+		return "", fmt.Errorf("marshaling directory object: %+v", err)
+	}
+
+	return string(result), nil
+}
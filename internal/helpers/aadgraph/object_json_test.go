@@ -0,0 +1,96 @@
+package aadgraph
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestCanonicalObjectJSON(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "metadata is removed and keys are sorted",
+			Input:    `{"odata.metadata":"https://graph.windows.net/metadata","objectType":"Group","displayName":"acctest","description":"test"}`,
+			Expected: `{"description":"test","displayName":"acctest","objectType":"Group"}`,
+		},
+		{
+			Name:     "nested keys are sorted",
+			Input:    `{"onPremisesExtensionAttributes":{"extensionAttribute2":"b","extensionAttribute1":"a"},"accountEnabled":true}`,
+			Expected: `{"accountEnabled":true,"onPremisesExtensionAttributes":{"extensionAttribute1":"a","extensionAttribute2":"b"}}`,
+		},
+		{
+			Name:     "password profile is redacted",
+			Input:    `{"userPrincipalName":"acctest@example.com","passwordProfile":{"password":"s3cr3t-p4ssw0rd","forceChangePasswordNextLogin":true}}`,
+			Expected: `{"passwordProfile":null,"userPrincipalName":"acctest@example.com"}`,
+		},
+		{
+			Name:     "password credential values are redacted",
+			Input:    `{"passwordCredentials":[{"value":"s3cr3t-p4ssw0rd","keyId":"00000000-0000-0000-0000-000000000000","endDate":"2030-01-01T00:00:00Z"}]}`,
+			Expected: `{"passwordCredentials":[{"endDate":"2030-01-01T00:00:00Z","keyId":"00000000-0000-0000-0000-000000000000","value":null}]}`,
+		},
+		{
+			Name:     "key credential values are redacted",
+			Input:    `{"keyCredentials":[{"value":"TUlJQ2VydGlmaWNhdGU=","type":"AsymmetricX509Cert","keyId":"11111111-1111-1111-1111-111111111111"}]}`,
+			Expected: `{"keyCredentials":[{"keyId":"11111111-1111-1111-1111-111111111111","type":"AsymmetricX509Cert","value":null}]}`,
+		},
+		{
+			Name:     "no credentials",
+			Input:    `{"keyCredentials":[],"passwordCredentials":null}`,
+			Expected: `{"keyCredentials":[],"passwordCredentials":null}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			object := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(tc.Input), &object); err != nil {
+				t.Fatalf("unmarshaling input: %+v", err)
+			}
+
+			result, err := CanonicalObjectJSON(object)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if result != tc.Expected {
+				t.Fatalf("expected %s, got %s", tc.Expected, result)
+			}
+
+			for _, secret := range []string{"s3cr3t-p4ssw0rd", "TUlJQ2VydGlmaWNhdGU="} {
+				if strings.Contains(result, secret) {
+					t.Fatalf("secret %q was not redacted from %s", secret, result)
+				}
+			}
+		})
+	}
+}
+
+func TestCanonicalObjectJSON_stable(t *testing.T) {
+	input := `{"zeta":1,"alpha":{"delta":[{"b":2,"a":1}],"charlie":3},"mike":"m","bravo":null}`
+
+	var previous string
+	for i := 0; i < 20; i++ {
+		object := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(input), &object); err != nil {
+			t.Fatalf("unmarshaling input: %+v", err)
+		}
+
+		result, err := CanonicalObjectJSON(object)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if i > 0 && result != previous {
+			t.Fatalf("expected stable output, got %s and then %s", previous, result)
+		}
+		previous = result
+	}
+
+	if expected := `{"alpha":{"charlie":3,"delta":[{"a":1,"b":2}]},"bravo":null,"mike":"m","zeta":1}`; previous != expected {
+		t.Fatalf("expected %s, got %s", expected, previous)
+	}
+}
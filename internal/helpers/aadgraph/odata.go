@@ -32,3 +32,8 @@ func OdataErrorContains(odata *graphrbac.OdataError, search string) bool {
 	}
 	return false
 }
+
+// ODataString escapes a value for use as a string literal in an OData filter
+func ODataString(in string) string {
+	return strings.ReplaceAll(in, "'", "''")
+}
@@ -0,0 +1,26 @@
+package aadgraph
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+// OwnersCheckSelfLockout returns an error when changing the owners of a directory object from `existing` to `desired`
+// would remove the authenticated principal, identified by `callerObjectId`, or would leave the object with no owners.
+// Either change can prevent Terraform from managing the object afterwards.
+func OwnersCheckSelfLockout(callerObjectId string, existing, desired []string) error {
+	if len(existing) > 0 && len(desired) == 0 {
+		return fmt.Errorf("this change would remove all owners, and the authenticated principal may no longer be able to manage the object")
+	}
+
+	if callerObjectId == "" {
+		return nil
+	}
+
+	if len(utils.Difference([]string{callerObjectId}, existing)) == 0 && len(utils.Difference([]string{callerObjectId}, desired)) > 0 {
+		return fmt.Errorf("this change would remove the authenticated principal (object ID %q) from the owners, and it may no longer be able to manage the object", callerObjectId)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package aadgraph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// ApplicationPatchProperties updates an application using an arbitrary set of properties. This is intended for
+// properties that are supported by the API but are not modelled by graphrbac.ApplicationUpdateParameters.
+func ApplicationPatchProperties(ctx context.Context, client *graphrbac.ApplicationsClient, objectId string, properties map[string]interface{}) (autorest.Response, error) {
+	resp, err := patchDirectoryObject(ctx, client.BaseClient, "applications", objectId, properties)
+	if err != nil {
+		return resp, fmt.Errorf("patching Application with ID %q: %+v", objectId, err)
+	}
+	return resp, nil
+}
+
+// GroupPatchProperties updates a group using an arbitrary set of properties. This is intended for properties that are
+// supported by the API but cannot be updated using graphrbac.GroupsClient.
+func GroupPatchProperties(ctx context.Context, client *graphrbac.GroupsClient, objectId string, properties map[string]interface{}) (autorest.Response, error) {
+	resp, err := patchDirectoryObject(ctx, client.BaseClient, "groups", objectId, properties)
+	if err != nil {
+		return resp, fmt.Errorf("patching Group with ID %q: %+v", objectId, err)
+	}
+	return resp, nil
+}
+
+// ServicePrincipalPatchProperties updates a service principal using an arbitrary set of properties. This is intended for
+// properties that are supported by the API but are not modelled by graphrbac.ServicePrincipalUpdateParameters.
+func ServicePrincipalPatchProperties(ctx context.Context, client *graphrbac.ServicePrincipalsClient, objectId string, properties map[string]interface{}) (autorest.Response, error) {
+	resp, err := patchDirectoryObject(ctx, client.BaseClient, "servicePrincipals", objectId, properties)
+	if err != nil {
+		return resp, fmt.Errorf("patching Service Principal with ID %q: %+v", objectId, err)
+	}
+	return resp, nil
+}
+
+func patchDirectoryObject(ctx context.Context, client graphrbac.BaseClient, collection, objectId string, properties map[string]interface{}) (result autorest.Response, err error) {
+	pathParameters := map[string]interface{}{
+		"collection": collection,
+		"objectId":   autorest.Encode("path", objectId),
+		"tenantID":   autorest.Encode("path", client.TenantID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": "1.6",
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{tenantID}/{collection}/{objectId}", pathParameters),
+		autorest.WithJSON(properties),
+		autorest.WithQueryParameters(queryParameters)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	result.Response = resp
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	if err = autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent), autorest.ByClosing()); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -69,3 +69,29 @@ func UserGetByMailNickname(ctx context.Context, client *graphrbac.UsersClient, m
 
 	return &user, nil
 }
+
+// UserFlattenOnPremisesExtensionAttributes returns the non-null extension attributes for a user
+func UserFlattenOnPremisesExtensionAttributes(props map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if v, ok := props["onPremisesExtensionAttributes"].(map[string]interface{}); ok {
+		for k, val := range v {
+			if s, ok := val.(string); ok && s != "" {
+				result[k] = s
+			}
+		}
+	}
+	return result
+}
+
+// UserExpandOnPremisesExtensionAttributes builds the payload for updating a user's extension attributes, clearing
+// any attributes which were previously set but are no longer desired
+func UserExpandOnPremisesExtensionAttributes(oldAttrs, newAttrs map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k := range oldAttrs {
+		result[k] = nil
+	}
+	for k, v := range newAttrs {
+		result[k] = v.(string)
+	}
+	return result
+}
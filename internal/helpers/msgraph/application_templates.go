@@ -0,0 +1,174 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// ApplicationTemplate is an application in the Azure AD application gallery.
+type ApplicationTemplate struct {
+	autorest.Response `json:"-"`
+
+	ID                         *string   `json:"id,omitempty"`
+	Categories                 *[]string `json:"categories,omitempty"`
+	Description                *string   `json:"description,omitempty"`
+	DisplayName                *string   `json:"displayName,omitempty"`
+	HomePageURL                *string   `json:"homePageUrl,omitempty"`
+	LogoURL                    *string   `json:"logoUrl,omitempty"`
+	Publisher                  *string   `json:"publisher,omitempty"`
+	SupportedProvisioningTypes *[]string `json:"supportedProvisioningTypes,omitempty"`
+	SupportedSingleSignOnModes *[]string `json:"supportedSingleSignOnModes,omitempty"`
+}
+
+// ApplicationTemplateListResult is a list of application templates.
+type ApplicationTemplateListResult struct {
+	autorest.Response `json:"-"`
+
+	Value *[]ApplicationTemplate `json:"value,omitempty"`
+}
+
+// ApplicationServicePrincipal is the application and service principal created when instantiating an application
+// template.
+type ApplicationServicePrincipal struct {
+	autorest.Response `json:"-"`
+
+	Application      *Application      `json:"application,omitempty"`
+	ServicePrincipal *ServicePrincipal `json:"servicePrincipal,omitempty"`
+}
+
+// Application contains the properties of an application which are needed to locate it using Azure Active Directory
+// Graph.
+type Application struct {
+	ID          *string `json:"id,omitempty"`
+	AppID       *string `json:"appId,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+// ApplicationTemplatesClient retrieves and instantiates application templates.
+type ApplicationTemplatesClient struct {
+	BaseClient
+}
+
+// NewApplicationTemplatesClientWithBaseURI creates an instance of the ApplicationTemplatesClient client using a custom
+// endpoint.
+func NewApplicationTemplatesClientWithBaseURI(baseURI string) ApplicationTemplatesClient {
+	return ApplicationTemplatesClient{NewWithBaseURI(baseURI)}
+}
+
+// Get retrieves the application template with the specified ID.
+func (client ApplicationTemplatesClient) Get(ctx context.Context, templateId string) (result ApplicationTemplate, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	pathParameters := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"templateId": autorest.Encode("path", templateId),
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applicationTemplates/{templateId}", pathParameters)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// List retrieves the application templates matching the specified OData filter.
+func (client ApplicationTemplatesClient) List(ctx context.Context, filter string) (result ApplicationTemplateListResult, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	pathParameters := map[string]interface{}{
+		"apiVersion": apiVersion,
+	}
+
+	queryParameters := map[string]interface{}{}
+	if filter != "" {
+		queryParameters["$filter"] = autorest.Encode("query", filter)
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applicationTemplates", pathParameters),
+		autorest.WithQueryParameters(queryParameters)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Instantiate creates an application and service principal from the application template with the specified ID.
+func (client ApplicationTemplatesClient) Instantiate(ctx context.Context, templateId, displayName string) (result ApplicationServicePrincipal, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	pathParameters := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"templateId": autorest.Encode("path", templateId),
+	}
+
+	body := map[string]interface{}{
+		"displayName": displayName,
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applicationTemplates/{templateId}/instantiate", pathParameters),
+		autorest.WithJSON(body)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+func (client ApplicationTemplatesClient) send(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
@@ -0,0 +1,36 @@
+// Package msgraph provides minimal clients for Microsoft Graph APIs which have no equivalent in Azure Active Directory
+// Graph, and which are therefore not available in the graphrbac SDK.
+package msgraph
+
+import (
+	"errors"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const apiVersion = "v1.0"
+
+// ErrNotAvailable is returned when Microsoft Graph is not available in the configured environment, or when the
+// provider was unable to obtain an authorization token for it
+var ErrNotAvailable = errors.New("Microsoft Graph is not available in the configured environment, or an authorization token could not be obtained for it")
+
+// BaseClient is the base client for Microsoft Graph.
+type BaseClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+// NewWithBaseURI creates an instance of the BaseClient client using a custom endpoint.
+func NewWithBaseURI(baseURI string) BaseClient {
+	return BaseClient{
+		Client:  autorest.NewClientWithUserAgent("msgraph"),
+		BaseURI: baseURI,
+	}
+}
+
+func (client BaseClient) available() error {
+	if client.BaseURI == "" || client.Authorizer == nil {
+		return ErrNotAvailable
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// DeletedItem is a directory object which has been soft-deleted and can either be restored or permanently deleted.
+type DeletedItem struct {
+	autorest.Response `json:"-"`
+
+	ID          *string `json:"id,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+// DeletedItemsClient manages soft-deleted directory objects.
+type DeletedItemsClient struct {
+	BaseClient
+}
+
+// NewDeletedItemsClientWithBaseURI creates an instance of the DeletedItemsClient client using a custom endpoint.
+func NewDeletedItemsClientWithBaseURI(baseURI string) DeletedItemsClient {
+	return DeletedItemsClient{NewWithBaseURI(baseURI)}
+}
+
+// Get retrieves the soft-deleted directory object with the specified object ID.
+func (client DeletedItemsClient) Get(ctx context.Context, objectId string) (result DeletedItem, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/directory/deletedItems/{objectId}", client.itemPathParameters(objectId))).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// PermanentlyDelete permanently deletes the soft-deleted directory object with the specified object ID. The object
+// cannot be restored afterwards.
+func (client DeletedItemsClient) PermanentlyDelete(ctx context.Context, objectId string) (result autorest.Response, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/directory/deletedItems/{objectId}", client.itemPathParameters(objectId))).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = resp
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	return
+}
+
+func (client DeletedItemsClient) itemPathParameters(objectId string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": apiVersion,
+		"objectId":   autorest.Encode("path", objectId),
+	}
+}
+
+func (client DeletedItemsClient) send(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
@@ -0,0 +1,176 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// FederatedIdentityCredential is a federated identity credential for an application, which allows tokens issued by an
+// external OpenID Connect identity provider to be exchanged for access tokens for the application.
+type FederatedIdentityCredential struct {
+	autorest.Response `json:"-"`
+
+	ID          *string   `json:"id,omitempty"`
+	Audiences   *[]string `json:"audiences,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Issuer      *string   `json:"issuer,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	Subject     *string   `json:"subject,omitempty"`
+}
+
+// FederatedIdentityCredentialsClient manages the federated identity credentials for applications.
+type FederatedIdentityCredentialsClient struct {
+	BaseClient
+}
+
+// NewFederatedIdentityCredentialsClientWithBaseURI creates an instance of the FederatedIdentityCredentialsClient client
+// using a custom endpoint.
+func NewFederatedIdentityCredentialsClientWithBaseURI(baseURI string) FederatedIdentityCredentialsClient {
+	return FederatedIdentityCredentialsClient{NewWithBaseURI(baseURI)}
+}
+
+// Create creates a federated identity credential for the application with the specified object ID.
+func (client FederatedIdentityCredentialsClient) Create(ctx context.Context, applicationObjectId string, credential FederatedIdentityCredential) (result FederatedIdentityCredential, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	// these properties are read-only
+	credential.ID = nil
+
+	pathParameters := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"objectId":   autorest.Encode("path", applicationObjectId),
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applications/{objectId}/federatedIdentityCredentials", pathParameters),
+		autorest.WithJSON(credential)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Get retrieves a federated identity credential for the application with the specified object ID.
+func (client FederatedIdentityCredentialsClient) Get(ctx context.Context, applicationObjectId, credentialId string) (result FederatedIdentityCredential, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applications/{objectId}/federatedIdentityCredentials/{credentialId}", client.itemPathParameters(applicationObjectId, credentialId))).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Update updates a federated identity credential for the application with the specified object ID. The name of a
+// federated identity credential cannot be changed.
+func (client FederatedIdentityCredentialsClient) Update(ctx context.Context, applicationObjectId string, credential FederatedIdentityCredential) (result autorest.Response, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	if credential.ID == nil {
+		return result, fmt.Errorf("cannot update federated identity credential with nil ID")
+	}
+	credentialId := *credential.ID
+
+	// these properties are read-only or cannot be changed
+	credential.ID = nil
+	credential.Name = nil
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applications/{objectId}/federatedIdentityCredentials/{credentialId}", client.itemPathParameters(applicationObjectId, credentialId)),
+		autorest.WithJSON(credential)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = resp
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	return
+}
+
+// Delete deletes a federated identity credential for the application with the specified object ID.
+func (client FederatedIdentityCredentialsClient) Delete(ctx context.Context, applicationObjectId, credentialId string) (result autorest.Response, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/applications/{objectId}/federatedIdentityCredentials/{credentialId}", client.itemPathParameters(applicationObjectId, credentialId))).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = resp
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	return
+}
+
+func (client FederatedIdentityCredentialsClient) itemPathParameters(applicationObjectId, credentialId string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion":   apiVersion,
+		"credentialId": autorest.Encode("path", credentialId),
+		"objectId":     autorest.Encode("path", applicationObjectId),
+	}
+}
+
+func (client FederatedIdentityCredentialsClient) send(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
@@ -0,0 +1,310 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// PolicyType is the name of the collection for a type of policy, e.g. `claimsMappingPolicies`
+type PolicyType string
+
+const (
+	PolicyTypeActivityBasedTimeout PolicyType = "activityBasedTimeoutPolicies"
+	PolicyTypeClaimsMapping        PolicyType = "claimsMappingPolicies"
+	PolicyTypeHomeRealmDiscovery   PolicyType = "homeRealmDiscoveryPolicies"
+	PolicyTypeTokenLifetime        PolicyType = "tokenLifetimePolicies"
+)
+
+// PolicyObjectType is the name of the collection for a type of directory object to which policies can be assigned
+type PolicyObjectType string
+
+const (
+	PolicyObjectTypeApplication      PolicyObjectType = "applications"
+	PolicyObjectTypeServicePrincipal PolicyObjectType = "servicePrincipals"
+)
+
+// Policy is a policy which controls Azure AD features for the applications and service principals to which it is
+// assigned. Definition contains a single JSON-encoded string describing the policy rules.
+type Policy struct {
+	autorest.Response `json:"-"`
+
+	ID                    *string   `json:"id,omitempty"`
+	Definition            *[]string `json:"definition,omitempty"`
+	Description           *string   `json:"description,omitempty"`
+	DisplayName           *string   `json:"displayName,omitempty"`
+	IsOrganizationDefault *bool     `json:"isOrganizationDefault,omitempty"`
+}
+
+// PolicyListResult is a list of policies.
+type PolicyListResult struct {
+	autorest.Response `json:"-"`
+
+	Value *[]Policy `json:"value,omitempty"`
+}
+
+// PoliciesClient manages policies and their assignments to applications and service principals.
+type PoliciesClient struct {
+	BaseClient
+}
+
+// NewPoliciesClientWithBaseURI creates an instance of the PoliciesClient client using a custom endpoint.
+func NewPoliciesClientWithBaseURI(baseURI string) PoliciesClient {
+	return PoliciesClient{NewWithBaseURI(baseURI)}
+}
+
+// Create creates a policy of the specified type.
+func (client PoliciesClient) Create(ctx context.Context, policyType PolicyType, policy Policy) (result Policy, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	// these properties are read-only
+	policy.ID = nil
+
+	pathParameters := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"policyType": autorest.Encode("path", policyType),
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/policies/{policyType}", pathParameters),
+		autorest.WithJSON(policy)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Get retrieves a policy of the specified type.
+func (client PoliciesClient) Get(ctx context.Context, policyType PolicyType, policyId string) (result Policy, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/policies/{policyType}/{policyId}", client.policyPathParameters(policyType, policyId))).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Update updates a policy of the specified type.
+func (client PoliciesClient) Update(ctx context.Context, policyType PolicyType, policy Policy) (result autorest.Response, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	if policy.ID == nil {
+		return result, fmt.Errorf("cannot update policy with nil ID")
+	}
+	policyId := *policy.ID
+
+	// these properties are read-only
+	policy.ID = nil
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/policies/{policyType}/{policyId}", client.policyPathParameters(policyType, policyId)),
+		autorest.WithJSON(policy)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = resp
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	return
+}
+
+// Delete deletes a policy of the specified type.
+func (client PoliciesClient) Delete(ctx context.Context, policyType PolicyType, policyId string) (result autorest.Response, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/policies/{policyType}/{policyId}", client.policyPathParameters(policyType, policyId))).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = resp
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	return
+}
+
+// Assign assigns a policy of the specified type to an application or service principal.
+func (client PoliciesClient) Assign(ctx context.Context, objectType PolicyObjectType, objectId string, policyType PolicyType, policyId string) (result autorest.Response, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	body := map[string]interface{}{
+		"@odata.id": fmt.Sprintf("%s/%s/policies/%s/%s", strings.TrimSuffix(client.BaseURI, "/"), apiVersion, policyType, policyId),
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/{objectType}/{objectId}/{policyType}/$ref", client.assignmentPathParameters(objectType, objectId, policyType, "")),
+		autorest.WithJSON(body)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = resp
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	return
+}
+
+// ListAssigned lists the policies of the specified type which are assigned to an application or service principal.
+func (client PoliciesClient) ListAssigned(ctx context.Context, objectType PolicyObjectType, objectId string, policyType PolicyType) (result PolicyListResult, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/{objectType}/{objectId}/{policyType}", client.assignmentPathParameters(objectType, objectId, policyType, ""))).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// Unassign removes the assignment of a policy of the specified type from an application or service principal.
+func (client PoliciesClient) Unassign(ctx context.Context, objectType PolicyObjectType, objectId string, policyType PolicyType, policyId string) (result autorest.Response, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/{objectType}/{objectId}/{policyType}/{policyId}/$ref", client.assignmentPathParameters(objectType, objectId, policyType, policyId))).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = resp
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	return
+}
+
+func (client PoliciesClient) policyPathParameters(policyType PolicyType, policyId string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": apiVersion,
+		"policyId":   autorest.Encode("path", policyId),
+		"policyType": autorest.Encode("path", policyType),
+	}
+}
+
+func (client PoliciesClient) assignmentPathParameters(objectType PolicyObjectType, objectId string, policyType PolicyType, policyId string) map[string]interface{} {
+	params := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"objectId":   autorest.Encode("path", objectId),
+		"objectType": autorest.Encode("path", objectType),
+		"policyType": autorest.Encode("path", policyType),
+	}
+	if policyId != "" {
+		params["policyId"] = autorest.Encode("path", policyId)
+	}
+	return params
+}
+
+func (client PoliciesClient) send(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// PolicyIDs returns the IDs of the policies in a list of policies.
+func PolicyIDs(result PolicyListResult) []string {
+	ids := make([]string, 0)
+	if result.Value != nil {
+		for _, p := range *result.Value {
+			if p.ID != nil {
+				ids = append(ids, *p.ID)
+			}
+		}
+	}
+	return ids
+}
@@ -0,0 +1,129 @@
+package msgraph
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// SelfSignedCertificate is a self-signed certificate generated for a service principal. Key contains the public
+// certificate only; the private key is never returned.
+type SelfSignedCertificate struct {
+	autorest.Response `json:"-"`
+
+	CustomKeyIdentifier *string    `json:"customKeyIdentifier,omitempty"`
+	DisplayName         *string    `json:"displayName,omitempty"`
+	EndDateTime         *date.Time `json:"endDateTime,omitempty"`
+	Key                 *string    `json:"key,omitempty"`
+	KeyID               *string    `json:"keyId,omitempty"`
+	StartDateTime       *date.Time `json:"startDateTime,omitempty"`
+	Thumbprint          *string    `json:"thumbprint,omitempty"`
+	Type                *string    `json:"type,omitempty"`
+	Usage               *string    `json:"usage,omitempty"`
+}
+
+// ServicePrincipal contains the properties of a service principal which are not available using Azure Active
+// Directory Graph.
+type ServicePrincipal struct {
+	autorest.Response `json:"-"`
+
+	ID                    *string `json:"id,omitempty"`
+	AppID                 *string `json:"appId,omitempty"`
+	ApplicationTemplateID *string `json:"applicationTemplateId,omitempty"`
+}
+
+// TokenSigningCertificateParameters are the parameters for generating a token signing certificate.
+type TokenSigningCertificateParameters struct {
+	DisplayName *string    `json:"displayName,omitempty"`
+	EndDateTime *date.Time `json:"endDateTime,omitempty"`
+}
+
+// ServicePrincipalsClient manages service principals.
+type ServicePrincipalsClient struct {
+	BaseClient
+}
+
+// NewServicePrincipalsClientWithBaseURI creates an instance of the ServicePrincipalsClient client using a custom
+// endpoint.
+func NewServicePrincipalsClientWithBaseURI(baseURI string) ServicePrincipalsClient {
+	return ServicePrincipalsClient{NewWithBaseURI(baseURI)}
+}
+
+// Get retrieves the service principal with the specified object ID.
+func (client ServicePrincipalsClient) Get(ctx context.Context, servicePrincipalObjectId string) (result ServicePrincipal, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	pathParameters := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"objectId":   autorest.Encode("path", servicePrincipalObjectId),
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/servicePrincipals/{objectId}", pathParameters)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// AddTokenSigningCertificate generates a self-signed token signing certificate for the service principal with the
+// specified object ID. The generated certificate is added to the service principal as a pair of key credentials, with
+// `Sign` and `Verify` usages, along with a password credential for the private key. All three credentials share the
+// same custom key identifier.
+func (client ServicePrincipalsClient) AddTokenSigningCertificate(ctx context.Context, servicePrincipalObjectId string, parameters TokenSigningCertificateParameters) (result SelfSignedCertificate, err error) {
+	if err = client.available(); err != nil {
+		return
+	}
+
+	pathParameters := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"objectId":   autorest.Encode("path", servicePrincipalObjectId),
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{apiVersion}/servicePrincipals/{objectId}/addTokenSigningCertificate", pathParameters),
+		autorest.WithJSON(parameters)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing request: %+v", err)
+	}
+
+	resp, err := client.send(req)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, fmt.Errorf("sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+func (client ServicePrincipalsClient) send(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
@@ -151,6 +151,20 @@ func AzureADProvider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_DISABLE_TERRAFORM_PARTNER_ID", false),
 				Description: "Disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
+
+			"allow_owner_self_removal": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ALLOW_OWNER_SELF_REMOVAL", false),
+				Description: "Allow changes to the `owners` of applications and groups which remove the authenticated principal, or which remove all owners.",
+			},
+
+			"add_caller_as_owner": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ADD_CALLER_AS_OWNER", false),
+				Description: "Add the authenticated principal as an owner of applications and groups created without any `owners`.",
+			},
 		},
 
 		ResourcesMap:   resources,
@@ -190,7 +204,15 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			partnerId = terraformPartnerId
 		}
 
-		return buildClient(ctx, p, builder, partnerId)
+		client, diags := buildClient(ctx, p, builder, partnerId)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		client.AllowOwnerSelfRemoval = d.Get("allow_owner_self_removal").(bool)
+		client.AddCallerAsOwner = d.Get("add_caller_as_owner").(bool)
+
+		return client, diags
 	}
 }
 
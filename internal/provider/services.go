@@ -4,6 +4,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azuread/internal/services/applications"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/services/domains"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/services/groups"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/policies"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/services/serviceprincipals"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/services/users"
 )
@@ -13,6 +14,7 @@ func SupportedServices() []ServiceRegistration {
 		applications.Registration{},
 		domains.Registration{},
 		groups.Registration{},
+		policies.Registration{},
 		serviceprincipals.Registration{},
 		users.Registration{},
 	}
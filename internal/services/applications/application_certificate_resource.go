@@ -50,21 +50,14 @@ func applicationCertificateResourceCreate(ctx context.Context, d *schema.Resourc
 	tf.LockByName(resourceApplicationName, id.ObjectId)
 	defer tf.UnlockByName(resourceApplicationName, id.ObjectId)
 
-	existingCreds, err := client.ListKeyCredentials(ctx, id.ObjectId)
-	if err != nil {
-		return tf.ErrorDiagPathF(err, "application_object_id", "Listing certificate credentials for application with ID %q", objectId)
-	}
-
-	newCreds, err := aadgraph.KeyCredentialResultAdd(existingCreds, cred)
+	err = aadgraph.KeyCredentialsUpdate(ctx, client, id.ObjectId, d.Timeout(schema.TimeoutCreate), func(existing graphrbac.KeyCredentialListResult) (*[]graphrbac.KeyCredential, error) {
+		return aadgraph.KeyCredentialResultAdd(existing, cred)
+	})
 	if err != nil {
 		if _, ok := err.(*aadgraph.AlreadyExistsError); ok {
 			return tf.ImportAsExistsDiag("azuread_application_certificate", id.String())
 		}
-		return tf.ErrorDiagF(err, "Adding application certificate")
-	}
-
-	if _, err = client.UpdateKeyCredentials(ctx, id.ObjectId, graphrbac.KeyCredentialsUpdateParameters{Value: newCreds}); err != nil {
-		return tf.ErrorDiagF(err, "Creating certificate credentials %q for application with object ID %q", id.KeyId, id.ObjectId)
+		return tf.ErrorDiagPathF(err, "application_object_id", "Creating certificate credentials %q for application with object ID %q", id.KeyId, id.ObjectId)
 	}
 
 	_, err = aadgraph.WaitForKeyCredentialReplication(ctx, id.KeyId, d.Timeout(schema.TimeoutCreate), func() (graphrbac.KeyCredentialListResult, error) {
@@ -152,18 +145,11 @@ func applicationCertificateResourceDelete(ctx context.Context, d *schema.Resourc
 		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with object ID %q", id.ObjectId)
 	}
 
-	existing, err := client.ListKeyCredentials(ctx, id.ObjectId)
-	if err != nil {
-		return tf.ErrorDiagF(err, "Listing certificate credential for application with object ID %q", id.ObjectId)
-	}
-
-	newCreds, err := aadgraph.KeyCredentialResultRemoveByKeyId(existing, id.KeyId)
+	err = aadgraph.KeyCredentialsUpdate(ctx, client, id.ObjectId, d.Timeout(schema.TimeoutDelete), func(existing graphrbac.KeyCredentialListResult) (*[]graphrbac.KeyCredential, error) {
+		return aadgraph.KeyCredentialResultRemoveByKeyId(existing, id.KeyId)
+	})
 	if err != nil {
-		return tf.ErrorDiagF(err, "Removing certificate credential %q from application with object ID %q", id.KeyId, id.ObjectId)
-	}
-
-	if _, err = client.UpdateKeyCredentials(ctx, id.ObjectId, graphrbac.KeyCredentialsUpdateParameters{Value: newCreds}); err != nil {
-		return tf.ErrorDiagF(err, "Removing certificate credential %q from application with object ID %q", id.KeyId, id.ObjectId)
+		return tf.ErrorDiagPathF(err, "application_object_id", "Removing certificate credential %q from application with object ID %q", id.KeyId, id.ObjectId)
 	}
 
 	return nil
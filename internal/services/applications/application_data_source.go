@@ -95,18 +95,31 @@ func applicationDataSource() *schema.Resource {
 				},
 			},
 
-			// TODO: v2.0 move this to `sign_in_audience` property and support other values
+			"app_role_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			// TODO: v2.0 remove this in favour of `sign_in_audience`
 			"available_to_other_tenants": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
 
+			"fallback_public_client": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"group_membership_claims": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
-			// TODO: v2.0 put this in a `web` block and remove Computed
+			// TODO: v2.0 remove this in favour of `web.0.homepage_url`
 			"homepage": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -120,13 +133,26 @@ func applicationDataSource() *schema.Resource {
 				},
 			},
 
-			// TODO: v2.0 put this in a `web` block
+			"known_client_applications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			// TODO: v2.0 remove this in favour of `web.0.logout_url`
+			"manifest_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"logout_url": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
-			// TODO: v2.0 put this in an `implicit_grant` block and rename to `access_token_issuance_enabled`
+			// TODO: v2.0 remove this in favour of `web.0.implicit_grant.0.access_token_issuance_enabled`
 			"oauth2_allow_implicit_flow": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -183,6 +209,14 @@ func applicationDataSource() *schema.Resource {
 				},
 			},
 
+			"oauth2_permission_scope_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"optional_claims": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -205,6 +239,22 @@ func applicationDataSource() *schema.Resource {
 				},
 			},
 
+			"public_client_platform": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"redirect_uris": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
 			"reply_urls": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -213,6 +263,11 @@ func applicationDataSource() *schema.Resource {
 				},
 			},
 
+			"requested_access_token_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
 			"required_resource_access": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -244,11 +299,76 @@ func applicationDataSource() *schema.Resource {
 				},
 			},
 
+			"sign_in_audience": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"spa": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"redirect_uris": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
 			// TODO: v2.0 drop this, there's no such distinction any more
 			"type": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"web": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"homepage_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"implicit_grant": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_token_issuance_enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+
+									"id_token_issuance_enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"logout_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"redirect_uris": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -328,20 +448,31 @@ func applicationDataSourceRead(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(*app.ObjectID)
 
 	tf.Set(d, "app_roles", aadgraph.FlattenAppRoles(app.AppRoles))
+	tf.Set(d, "app_role_ids", aadgraph.FlattenAppRoleIDs(app.AppRoles))
 	tf.Set(d, "application_id", app.AppID)
 	tf.Set(d, "available_to_other_tenants", app.AvailableToOtherTenants)
 	tf.Set(d, "display_name", app.DisplayName)
+	tf.Set(d, "fallback_public_client", app.PublicClient)
 	tf.Set(d, "group_membership_claims", app.GroupMembershipClaims)
 	tf.Set(d, "homepage", app.Homepage)
 	tf.Set(d, "identifier_uris", tf.FlattenStringSlicePtr(app.IdentifierUris))
+	tf.Set(d, "known_client_applications", tf.FlattenStringSlicePtr(app.KnownClientApplications))
 	tf.Set(d, "logout_url", app.LogoutURL)
 	tf.Set(d, "name", app.DisplayName)
 	tf.Set(d, "oauth2_allow_implicit_flow", app.Oauth2AllowImplicitFlow)
 	tf.Set(d, "oauth2_permissions", aadgraph.FlattenOauth2Permissions(app.Oauth2Permissions))
+	tf.Set(d, "oauth2_permission_scope_ids", aadgraph.FlattenOauth2PermissionScopeIDs(app.Oauth2Permissions))
 	tf.Set(d, "object_id", app.ObjectID)
 	tf.Set(d, "optional_claims", flattenApplicationOptionalClaimsAad(app.OptionalClaims))
 	tf.Set(d, "reply_urls", tf.FlattenStringSlicePtr(app.ReplyUrls))
+	tf.Set(d, "requested_access_token_version", flattenApplicationAccessTokenVersion(app))
 	tf.Set(d, "required_resource_access", flattenApplicationRequiredResourceAccessAad(app.RequiredResourceAccess))
+	tf.Set(d, "sign_in_audience", app.SignInAudience)
+
+	webRedirectUris, spaRedirectUris, publicClientRedirectUris := flattenApplicationRedirectUris(app)
+	tf.Set(d, "public_client_platform", flattenApplicationRedirectUrisBlock(publicClientRedirectUris))
+	tf.Set(d, "spa", flattenApplicationRedirectUrisBlock(spaRedirectUris))
+	tf.Set(d, "web", flattenApplicationWeb(app, webRedirectUris))
 
 	var appType string
 	if v := app.PublicClient; v != nil && *v {
@@ -358,5 +489,11 @@ func applicationDataSourceRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	tf.Set(d, "owners", owners)
 
+	manifest, err := aadgraph.ApplicationObjectJSON(ctx, client, *app.ObjectID)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "manifest_json", "Retrieving JSON representation of application with object ID %q", *app.ObjectID)
+	}
+	tf.Set(d, "manifest_json", manifest)
+
 	return nil
 }
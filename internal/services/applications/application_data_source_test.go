@@ -68,13 +68,21 @@ func (ApplicationDataSource) testCheck(data acceptance.TestData) resource.TestCh
 		check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctest-APP-%d", data.RandomInteger)),
 		check.That(data.ResourceName).Key("homepage").HasValue(fmt.Sprintf("https://homepage-%d", data.RandomInteger)),
 		check.That(data.ResourceName).Key("identifier_uris.#").HasValue("1"),
+		check.That(data.ResourceName).Key("manifest_json").Exists(),
 		check.That(data.ResourceName).Key("reply_urls.#").HasValue("1"),
 		check.That(data.ResourceName).Key("oauth2_allow_implicit_flow").HasValue("true"),
+		check.That(data.ResourceName).Key("web.0.homepage_url").HasValue(fmt.Sprintf("https://homepage-%d", data.RandomInteger)),
+		check.That(data.ResourceName).Key("web.0.implicit_grant.0.access_token_issuance_enabled").HasValue("true"),
+		check.That(data.ResourceName).Key("web.0.redirect_uris.#").HasValue("1"),
 		check.That(data.ResourceName).Key("optional_claims.#").HasValue("1"),
 		check.That(data.ResourceName).Key("optional_claims.0.access_token.#").HasValue("2"),
 		check.That(data.ResourceName).Key("optional_claims.0.id_token.#").HasValue("1"),
 		check.That(data.ResourceName).Key("required_resource_access.#").HasValue("2"),
 		check.That(data.ResourceName).Key("group_membership_claims").HasValue("All"),
+		check.That(data.ResourceName).Key("app_role_ids.%").HasValue("0"),
+		check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("2"),
+		check.That(data.ResourceName).Key("oauth2_permission_scope_ids.administer").IsUuid(),
+		check.That(data.ResourceName).Key("oauth2_permission_scope_ids.user_impersonation").IsUuid(),
 	)
 }
 
@@ -0,0 +1,221 @@
+package applications
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func applicationFederatedIdentityCredentialResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: applicationFederatedIdentityCredentialResourceCreate,
+		UpdateContext: applicationFederatedIdentityCredentialResourceUpdate,
+		ReadContext:   applicationFederatedIdentityCredentialResourceRead,
+		DeleteContext: applicationFederatedIdentityCredentialResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.FederatedIdentityCredentialID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 120),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`), "must start with a letter or number and contain only letters, numbers, hyphens and underscores"),
+				),
+			},
+
+			"audiences": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"issuer": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.FederatedIdentityCredentialIssuer,
+			},
+
+			"subject": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.FederatedIdentityCredentialSubject,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 600),
+			},
+
+			"credential_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func applicationFederatedIdentityCredentialResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	appClient := meta.(*clients.Client).Applications.AadClient
+	client := meta.(*clients.Client).Applications.FederatedIdentityCredentialsClient
+
+	objectId := d.Get("application_object_id").(string)
+
+	tf.LockByName(resourceApplicationName, objectId)
+	defer tf.UnlockByName(resourceApplicationName, objectId)
+
+	// ensure the Application Object exists
+	app, err := appClient.Get(ctx, objectId)
+	if err != nil {
+		if utils.ResponseWasNotFound(app.Response) {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", objectId)
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with object ID %q", objectId)
+	}
+
+	properties := msgraph.FederatedIdentityCredential{
+		Audiences:   tf.ExpandStringSlicePtr(d.Get("audiences").([]interface{})),
+		Description: utils.String(d.Get("description").(string)),
+		Issuer:      utils.String(d.Get("issuer").(string)),
+		Name:        utils.String(d.Get("display_name").(string)),
+		Subject:     utils.String(d.Get("subject").(string)),
+	}
+
+	credential, err := client.Create(ctx, objectId, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Adding federated identity credential for application with object ID %q", objectId)
+	}
+
+	if credential.ID == nil || *credential.ID == "" {
+		return tf.ErrorDiagF(nil, "Bad API response: nil or empty ID returned for federated identity credential on application with object ID %q", objectId)
+	}
+
+	id := parse.NewFederatedIdentityCredentialID(objectId, *credential.ID)
+
+	_, err = aadgraph.WaitForCreationReplication(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		credential, err := client.Get(ctx, id.ObjectId, id.CredentialId)
+		return credential.Response, err
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for federated identity credential replication for application (ObjectID %q, CredentialID %q)", id.ObjectId, id.CredentialId)
+	}
+
+	d.SetId(id.String())
+
+	return applicationFederatedIdentityCredentialResourceRead(ctx, d, meta)
+}
+
+func applicationFederatedIdentityCredentialResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.FederatedIdentityCredentialsClient
+
+	id, err := parse.FederatedIdentityCredentialID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing federated identity credential ID %q", d.Id())
+	}
+
+	tf.LockByName(resourceApplicationName, id.ObjectId)
+	defer tf.UnlockByName(resourceApplicationName, id.ObjectId)
+
+	properties := msgraph.FederatedIdentityCredential{
+		ID:          utils.String(id.CredentialId),
+		Audiences:   tf.ExpandStringSlicePtr(d.Get("audiences").([]interface{})),
+		Description: utils.String(d.Get("description").(string)),
+		Issuer:      utils.String(d.Get("issuer").(string)),
+		Subject:     utils.String(d.Get("subject").(string)),
+	}
+
+	if _, err := client.Update(ctx, id.ObjectId, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating federated identity credential with ID %q for application with object ID %q", id.CredentialId, id.ObjectId)
+	}
+
+	return applicationFederatedIdentityCredentialResourceRead(ctx, d, meta)
+}
+
+func applicationFederatedIdentityCredentialResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.FederatedIdentityCredentialsClient
+
+	id, err := parse.FederatedIdentityCredentialID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing federated identity credential ID %q", d.Id())
+	}
+
+	credential, err := client.Get(ctx, id.ObjectId, id.CredentialId)
+	if err != nil {
+		// either the parent Application or the credential has been removed - skip it
+		if utils.ResponseWasNotFound(credential.Response) {
+			log.Printf("[DEBUG] Federated identity credential %q for Application %q was not found - removing from state!", id.CredentialId, id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving federated identity credential with ID %q for application with object ID %q", id.CredentialId, id.ObjectId)
+	}
+
+	tf.Set(d, "application_object_id", id.ObjectId)
+	tf.Set(d, "audiences", tf.FlattenStringSlicePtr(credential.Audiences))
+	tf.Set(d, "credential_id", id.CredentialId)
+	tf.Set(d, "description", credential.Description)
+	tf.Set(d, "display_name", credential.Name)
+	tf.Set(d, "issuer", credential.Issuer)
+	tf.Set(d, "subject", credential.Subject)
+
+	return nil
+}
+
+func applicationFederatedIdentityCredentialResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.FederatedIdentityCredentialsClient
+
+	id, err := parse.FederatedIdentityCredentialID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing federated identity credential ID %q", d.Id())
+	}
+
+	tf.LockByName(resourceApplicationName, id.ObjectId)
+	defer tf.UnlockByName(resourceApplicationName, id.ObjectId)
+
+	if resp, err := client.Delete(ctx, id.ObjectId, id.CredentialId); err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Federated identity credential %q for Application %q was not found - assuming removed", id.CredentialId, id.ObjectId)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing federated identity credential with ID %q from application with object ID %q", id.CredentialId, id.ObjectId)
+	}
+
+	return nil
+}
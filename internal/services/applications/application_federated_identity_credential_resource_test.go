@@ -0,0 +1,118 @@
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+type ApplicationFederatedIdentityCredentialResource struct{}
+
+func TestAccApplicationFederatedIdentityCredential_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_federated_identity_credential", "test")
+	r := ApplicationFederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationFederatedIdentityCredential_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_federated_identity_credential", "test")
+	r := ApplicationFederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Deployments from the main branch"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationFederatedIdentityCredentialResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.FederatedIdentityCredentialID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Federated Identity Credential ID: %v", err)
+	}
+
+	resp, err := clients.Applications.FederatedIdentityCredentialsClient.Get(ctx, id.ObjectId, id.CredentialId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("Federated Identity Credential %q for Application with object ID %q does not exist", id.CredentialId, id.ObjectId)
+		}
+		return nil, fmt.Errorf("failed to retrieve Federated Identity Credential %q for Application with object ID %q: %+v", id.CredentialId, id.ObjectId, err)
+	}
+
+	return utils.Bool(resp.ID != nil && *resp.ID == id.CredentialId), nil
+}
+
+func (ApplicationFederatedIdentityCredentialResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestApp-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationFederatedIdentityCredentialResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_object_id = azuread_application.test.object_id
+  display_name          = "acctest-%[2]d"
+  audiences             = ["api://AzureADTokenExchange"]
+  issuer                = "https://token.actions.githubusercontent.com"
+  subject               = "repo:hashicorp/acctest-%[2]d:environment:production"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationFederatedIdentityCredentialResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_federated_identity_credential" "test" {
+  application_object_id = azuread_application.test.object_id
+  display_name          = "acctest-%[2]d"
+  description           = "Deployments from the main branch"
+  audiences             = ["api://AzureADTokenExchange"]
+  issuer                = "https://token.actions.githubusercontent.com"
+  subject               = "repo:hashicorp/acctest-%[2]d:ref:refs/heads/main"
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,97 @@
+package applications
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// maximum size of an application logo image, as enforced by Azure Active Directory
+const applicationLogoImageMaxBytes = 100 * 1024
+
+// image formats accepted by Azure Active Directory for application logos
+var applicationLogoImageContentTypes = []string{
+	"image/bmp",
+	"image/gif",
+	"image/jpeg",
+	"image/png",
+}
+
+// applicationLogoImage loads a logo image, which can be specified either as the path to a local file or as
+// base64-encoded content, and returns the image content along with its detected content type
+func applicationLogoImage(value string) (content []byte, contentType string, err error) {
+	if _, statErr := os.Stat(value); statErr == nil {
+		if content, err = ioutil.ReadFile(value); err != nil {
+			return nil, "", fmt.Errorf("reading logo image file %q: %+v", value, err)
+		}
+	} else if content, err = base64.StdEncoding.DecodeString(strings.TrimSpace(value)); err != nil {
+		return nil, "", fmt.Errorf("logo image must be either the path to an existing file or base64-encoded image content")
+	}
+
+	if len(content) == 0 {
+		return nil, "", fmt.Errorf("logo image must not be empty")
+	}
+
+	if len(content) > applicationLogoImageMaxBytes {
+		return nil, "", fmt.Errorf("logo image must be no larger than %d KB, got %d bytes", applicationLogoImageMaxBytes/1024, len(content))
+	}
+
+	contentType = http.DetectContentType(content)
+	for _, v := range applicationLogoImageContentTypes {
+		if contentType == v {
+			return content, contentType, nil
+		}
+	}
+
+	return nil, "", fmt.Errorf("logo image must be one of %s, detected %q", strings.Join(applicationLogoImageContentTypes, ", "), contentType)
+}
+
+// applicationLogoImageHash returns a hex-encoded SHA-256 hash of the logo image content, which is used for detecting
+// changes to the image content and drift of the uploaded logo
+func applicationLogoImageHash(content []byte) string {
+	if len(content) == 0 {
+		return ""
+	}
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// applicationLogoImageStateFunc stores a hash of the logo image content in state, rather than the image itself
+func applicationLogoImageStateFunc(i interface{}) string {
+	content, _, err := applicationLogoImage(i.(string))
+	if err != nil {
+		return ""
+	}
+	return applicationLogoImageHash(content)
+}
+
+func validateApplicationLogoImage(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if _, _, err := applicationLogoImage(v); err != nil {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid logo image",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return
+}
@@ -61,21 +61,14 @@ func applicationPasswordResourceCreate(ctx context.Context, d *schema.ResourceDa
 	tf.LockByName(resourceApplicationName, id.ObjectId)
 	defer tf.UnlockByName(resourceApplicationName, id.ObjectId)
 
-	existingCreds, err := client.ListPasswordCredentials(ctx, id.ObjectId)
-	if err != nil {
-		return tf.ErrorDiagPathF(err, "application_object_id", "Listing password credentials for application with ID %q", objectId)
-	}
-
-	newCreds, err := aadgraph.PasswordCredentialResultAdd(existingCreds, cred)
+	err = aadgraph.PasswordCredentialsUpdate(ctx, client, id.ObjectId, d.Timeout(schema.TimeoutCreate), func(existing graphrbac.PasswordCredentialListResult) (*[]graphrbac.PasswordCredential, error) {
+		return aadgraph.PasswordCredentialResultAdd(existing, cred)
+	})
 	if err != nil {
 		if _, ok := err.(*aadgraph.AlreadyExistsError); ok {
 			return tf.ImportAsExistsDiag("azuread_application_password", id.String())
 		}
-		return tf.ErrorDiagF(err, "Adding application password")
-	}
-
-	if _, err = client.UpdatePasswordCredentials(ctx, id.ObjectId, graphrbac.PasswordCredentialsUpdateParameters{Value: newCreds}); err != nil {
-		return tf.ErrorDiagF(err, "Creating password credentials %q for application with object ID %q", id.KeyId, id.ObjectId)
+		return tf.ErrorDiagPathF(err, "application_object_id", "Creating password credentials %q for application with object ID %q", id.KeyId, id.ObjectId)
 	}
 
 	_, err = aadgraph.WaitForPasswordCredentialReplication(ctx, id.KeyId, d.Timeout(schema.TimeoutCreate), func() (graphrbac.PasswordCredentialListResult, error) {
@@ -167,18 +160,11 @@ func applicationPasswordResourceDelete(ctx context.Context, d *schema.ResourceDa
 		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving application with ID %q", id.ObjectId)
 	}
 
-	existing, err := client.ListPasswordCredentials(ctx, id.ObjectId)
-	if err != nil {
-		return tf.ErrorDiagF(err, "Listing password credentials for application with object ID %q", id.ObjectId)
-	}
-
-	newCreds, err := aadgraph.PasswordCredentialResultRemoveByKeyId(existing, id.KeyId)
+	err = aadgraph.PasswordCredentialsUpdate(ctx, client, id.ObjectId, d.Timeout(schema.TimeoutDelete), func(existing graphrbac.PasswordCredentialListResult) (*[]graphrbac.PasswordCredential, error) {
+		return aadgraph.PasswordCredentialResultRemoveByKeyId(existing, id.KeyId)
+	})
 	if err != nil {
-		return tf.ErrorDiagF(err, "Removing password credential %q from application with object ID %q", id.KeyId, id.ObjectId)
-	}
-
-	if _, err = client.UpdatePasswordCredentials(ctx, id.ObjectId, graphrbac.PasswordCredentialsUpdateParameters{Value: newCreds}); err != nil {
-		return tf.ErrorDiagF(err, "Removing password credential %q from application with object ID %q", id.KeyId, id.ObjectId)
+		return tf.ErrorDiagPathF(err, "application_object_id", "Removing password credential %q from application with object ID %q", id.KeyId, id.ObjectId)
 	}
 
 	return nil
@@ -0,0 +1,216 @@
+package applications
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func applicationPreAuthorizedResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: applicationPreAuthorizedResourceCreateUpdate,
+		UpdateContext: applicationPreAuthorizedResourceCreateUpdate,
+		ReadContext:   applicationPreAuthorizedResourceRead,
+		DeleteContext: applicationPreAuthorizedResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ApplicationPreAuthorizedID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"application_object_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"authorized_app_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"permission_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.UUID,
+				},
+			},
+		},
+	}
+}
+
+func applicationPreAuthorizedResourceCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.AadClient
+
+	id := parse.NewApplicationPreAuthorizedID(d.Get("application_object_id").(string), d.Get("authorized_app_id").(string))
+	permissionIds := tf.ExpandStringSlicePtr(d.Get("permission_ids").(*schema.Set).List())
+
+	preAuthorized := graphrbac.PreAuthorizedApplication{
+		AppID: utils.String(id.AppId),
+		Permissions: &[]graphrbac.PreAuthorizedApplicationPermission{
+			{
+				DirectAccessGrant: utils.Bool(false),
+				AccessGrants:      permissionIds,
+			},
+		},
+		Extensions: &[]graphrbac.PreAuthorizedApplicationExtension{},
+	}
+
+	tf.LockByName(resourceApplicationName, id.ObjectId)
+	defer tf.UnlockByName(resourceApplicationName, id.ObjectId)
+
+	// ensure the Application Object exists
+	app, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		if utils.ResponseWasNotFound(app.Response) {
+			return tf.ErrorDiagPathF(nil, "application_object_id", "Application with object ID %q was not found", id.ObjectId)
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with object ID %q", id.ObjectId)
+	}
+
+	// the permissions being granted must be OAuth2 Permissions exposed by the Application
+	for _, permissionId := range *permissionIds {
+		if existing, _ := aadgraph.OAuth2PermissionFindById(app, permissionId); existing == nil {
+			return tf.ErrorDiagPathF(fmt.Errorf("OAuth2 Permission with ID %q was not found for Application %q", permissionId, id.ObjectId),
+				"permission_ids", "Pre-authorizing application with app ID %q", id.AppId)
+		}
+	}
+
+	var newPreAuthorized *[]graphrbac.PreAuthorizedApplication
+
+	if d.IsNewResource() {
+		newPreAuthorized, err = aadgraph.PreAuthorizedApplicationAdd(app.PreAuthorizedApplications, &preAuthorized)
+		if err != nil {
+			if _, ok := err.(*aadgraph.AlreadyExistsError); ok {
+				return tf.ImportAsExistsDiag("azuread_application_pre_authorized", id.String())
+			}
+			return tf.ErrorDiagF(err, "Failed to add Pre-Authorized Application")
+		}
+	} else {
+		if existing, _ := aadgraph.PreAuthorizedApplicationFindByAppId(app, id.AppId); existing == nil {
+			return tf.ErrorDiagPathF(nil, "authorized_app_id", "Pre-Authorized Application with app ID %q was not found for Application %q", id.AppId, id.ObjectId)
+		}
+
+		newPreAuthorized, err = aadgraph.PreAuthorizedApplicationUpdate(app.PreAuthorizedApplications, &preAuthorized)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Updating Pre-Authorized Application with app ID %q", id.AppId)
+		}
+	}
+
+	properties := graphrbac.ApplicationUpdateParameters{
+		PreAuthorizedApplications: newPreAuthorized,
+	}
+	if _, err := client.Patch(ctx, id.ObjectId, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating Application with ID %q", id.ObjectId)
+	}
+
+	d.SetId(id.String())
+
+	return applicationPreAuthorizedResourceRead(ctx, d, meta)
+}
+
+func applicationPreAuthorizedResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.AadClient
+
+	id, err := parse.ApplicationPreAuthorizedID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Pre-Authorized Application ID %q", d.Id())
+	}
+
+	// ensure the Application Object exists
+	app, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		// the parent Application has been removed - skip it
+		if utils.ResponseWasNotFound(app.Response) {
+			log.Printf("[DEBUG] Application with Object ID %q was not found - removing from state!", id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with object ID %q", id.ObjectId)
+	}
+
+	preAuthorized, err := aadgraph.PreAuthorizedApplicationFindByAppId(app, id.AppId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Identifying Pre-Authorized Application")
+	}
+
+	if preAuthorized == nil {
+		log.Printf("[DEBUG] Pre-Authorized Application %q (ID %q) was not found - removing from state!", id.AppId, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	permissionIds := make([]string, 0)
+	if preAuthorized.Permissions != nil {
+		for _, permission := range *preAuthorized.Permissions {
+			if permission.AccessGrants != nil {
+				permissionIds = append(permissionIds, *permission.AccessGrants...)
+			}
+		}
+	}
+
+	tf.Set(d, "application_object_id", id.ObjectId)
+	tf.Set(d, "authorized_app_id", id.AppId)
+	tf.Set(d, "permission_ids", permissionIds)
+
+	return nil
+}
+
+func applicationPreAuthorizedResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.AadClient
+
+	id, err := parse.ApplicationPreAuthorizedID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Pre-Authorized Application ID %q", d.Id())
+	}
+
+	tf.LockByName(resourceApplicationName, id.ObjectId)
+	defer tf.UnlockByName(resourceApplicationName, id.ObjectId)
+
+	// ensure the parent Application exists
+	app, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		// the parent Application has been removed - skip it
+		if utils.ResponseWasNotFound(app.Response) {
+			log.Printf("[DEBUG] Application with Object ID %q was not found - removing from state!", id.ObjectId)
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "application_object_id", "Retrieving Application with ID %q", id.ObjectId)
+	}
+
+	if app.PreAuthorizedApplications == nil {
+		return nil
+	}
+
+	newPreAuthorized, err := aadgraph.PreAuthorizedApplicationResultRemoveByAppId(app.PreAuthorizedApplications, id.AppId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Removing Pre-Authorized Application with app ID %q for application %q", id.AppId, id.ObjectId)
+	}
+
+	properties := graphrbac.ApplicationUpdateParameters{
+		PreAuthorizedApplications: newPreAuthorized,
+	}
+	if _, err := client.Patch(ctx, id.ObjectId, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating Application with ID %q", id.ObjectId)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/applications/parse"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+type ApplicationPreAuthorizedResource struct{}
+
+func TestAccApplicationPreAuthorized_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_pre_authorized", "test")
+	r := ApplicationPreAuthorizedResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permission_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationPreAuthorized_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_pre_authorized", "test")
+	r := ApplicationPreAuthorizedResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permission_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permission_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permission_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationPreAuthorized_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_pre_authorized", "test")
+	r := ApplicationPreAuthorizedResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r ApplicationPreAuthorizedResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationPreAuthorizedID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Pre-Authorized Application ID: %v", err)
+	}
+
+	resp, err := clients.Applications.AadClient.Get(ctx, id.ObjectId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("Application with object ID %q does not exist", id.ObjectId)
+		}
+		return nil, fmt.Errorf("failed to retrieve Application with object ID %q: %+v", id.ObjectId, err)
+	}
+
+	preAuthorized, err := aadgraph.PreAuthorizedApplicationFindByAppId(resp, id.AppId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to identity Pre-Authorized Application: %s", err)
+	} else if preAuthorized != nil {
+		return utils.Bool(true), nil
+	}
+
+	return nil, fmt.Errorf("Pre-Authorized Application %q was not found in Application %q", id.AppId, id.ObjectId)
+}
+
+func (ApplicationPreAuthorizedResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "authorized" {
+  display_name = "acctestApp-authorized-%[1]d"
+}
+
+resource "azuread_application" "authorizer" {
+  display_name = "acctestApp-authorizer-%[1]d"
+
+  oauth2_permissions {
+    admin_consent_description  = "Administer the application"
+    admin_consent_display_name = "Administer"
+    is_enabled                 = true
+    type                       = "Admin"
+    value                      = "administer"
+  }
+
+  oauth2_permissions {
+    admin_consent_description  = "Access the application"
+    admin_consent_display_name = "Access"
+    is_enabled                 = true
+    type                       = "User"
+    user_consent_description   = "Access the application"
+    user_consent_display_name  = "Access"
+    value                      = "user_impersonation"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationPreAuthorizedResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_pre_authorized" "test" {
+  application_object_id = azuread_application.authorizer.object_id
+  authorized_app_id     = azuread_application.authorized.application_id
+  permission_ids        = [for p in azuread_application.authorizer.oauth2_permissions : p.id if p.value == "user_impersonation"]
+}
+`, r.template(data))
+}
+
+func (r ApplicationPreAuthorizedResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_pre_authorized" "test" {
+  application_object_id = azuread_application.authorizer.object_id
+  authorized_app_id     = azuread_application.authorized.application_id
+  permission_ids        = [for p in azuread_application.authorizer.oauth2_permissions : p.id]
+}
+`, r.template(data))
+}
+
+func (r ApplicationPreAuthorizedResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_pre_authorized" "import" {
+  application_object_id = azuread_application_pre_authorized.test.application_object_id
+  authorized_app_id     = azuread_application_pre_authorized.test.authorized_app_id
+  permission_ids        = azuread_application_pre_authorized.test.permission_ids
+}
+`, r.basic(data))
+}
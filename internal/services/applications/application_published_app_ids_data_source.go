@@ -0,0 +1,45 @@
+package applications
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+)
+
+func applicationPublishedAppIdsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: applicationPublishedAppIdsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"result": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func applicationPublishedAppIdsDataSourceRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	result := make(map[string]interface{}, len(publishedAppIds))
+	for name, appId := range publishedAppIds {
+		result[name] = appId
+	}
+
+	d.SetId("appIds-" + publishedAppIdsVersion)
+
+	tf.Set(d, "result", result)
+	tf.Set(d, "version", publishedAppIdsVersion)
+
+	return nil
+}
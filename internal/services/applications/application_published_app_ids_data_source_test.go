@@ -0,0 +1,32 @@
+package applications_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApplicationPublishedAppIdsDataSource struct{}
+
+func TestAccApplicationPublishedAppIdsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_application_published_app_ids", "test")
+	r := ApplicationPublishedAppIdsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("result.MicrosoftGraph").HasValue("00000003-0000-0000-c000-000000000000"),
+				check.That(data.ResourceName).Key("result.AzureServiceManagement").IsUuid(),
+				check.That(data.ResourceName).Key("version").Exists(),
+			),
+		},
+	})
+}
+
+func (ApplicationPublishedAppIdsDataSource) basic() string {
+	return `data "azuread_application_published_app_ids" "test" {}`
+}
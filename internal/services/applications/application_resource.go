@@ -5,9 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +19,7 @@ import (
 
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
@@ -22,6 +27,41 @@ import (
 
 const resourceApplicationName = "azuread_application"
 
+const (
+	signInAudienceMyOrg                   = "AzureADMyOrg"
+	signInAudienceMultipleOrgs            = "AzureADMultipleOrgs"
+	signInAudienceMultipleOrgsAndPersonal = "AzureADandPersonalMicrosoftAccount"
+	signInAudiencePersonal                = "PersonalMicrosoftAccount"
+)
+
+// maximum number of identifier URIs permitted for applications supporting personal Microsoft accounts
+const signInAudiencePersonalMaxIdentifierUris = 50
+
+// ownership modes for the `app_role` and `oauth2_permissions` blocks
+const (
+	// the inline blocks declare the complete set of app roles and permission scopes for the application
+	applicationRoleAndScopeOwnershipAuthoritative = "Authoritative"
+
+	// only app roles and permission scopes declared inline are managed, others are ignored
+	applicationRoleAndScopeOwnershipDeclaredOnly = "DeclaredOnly"
+)
+
+// behaviours when the resource is destroyed
+const (
+	// the application is soft-deleted, and can be restored for 30 days
+	applicationDestroyBehaviorSoftDelete = "SoftDelete"
+
+	// the application is soft-deleted and then permanently deleted
+	applicationDestroyBehaviorHardDelete = "HardDelete"
+)
+
+// reply URL types, which correspond to the `web`, `spa` and `public_client_platform` blocks respectively
+const (
+	applicationReplyUrlTypeWeb             = "Web"
+	applicationReplyUrlTypeSpa             = "Spa"
+	applicationReplyUrlTypeInstalledClient = "InstalledClient"
+)
+
 func applicationResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: applicationResourceCreate,
@@ -36,12 +76,18 @@ func applicationResource() *schema.Resource {
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 
-		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
-			if _, err := uuid.ParseUUID(id); err != nil {
-				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
-			}
-			return nil
-		}),
+		Importer: tf.ResolveResourceIDPriorToImport(applicationResourceImportResolve),
+
+		CustomizeDiff: applicationResourceCustomizeDiff,
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    applicationResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: applicationResourceStateUpgradeV0,
+				Version: 0,
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"display_name": {
@@ -62,6 +108,8 @@ func applicationResource() *schema.Resource {
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
+			"additional_properties": aadgraph.AdditionalPropertiesSchema(),
+
 			"app_role": {
 				Type:       schema.TypeSet,
 				Optional:   true,
@@ -115,10 +163,13 @@ func applicationResource() *schema.Resource {
 				},
 			},
 
-			// TODO: v2.0 move this to `sign_in_audience` property and support other values
+			// TODO: v2.0 remove this in favour of `sign_in_audience`
 			"available_to_other_tenants": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "This property is deprecated in favour of `sign_in_audience` and will be removed in version 2.0 of this provider.",
+				ConflictsWith: []string{"sign_in_audience"},
 			},
 
 			"group_membership_claims": {
@@ -134,11 +185,36 @@ func applicationResource() *schema.Resource {
 				}, false),
 			},
 
-			// TODO: v2.0 put this in a `web` block and remove Computed
+			"default_identifier_uri": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"destroy_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  applicationDestroyBehaviorSoftDelete,
+				ValidateFunc: validation.StringInSlice([]string{
+					applicationDestroyBehaviorSoftDelete,
+					applicationDestroyBehaviorHardDelete,
+				}, false),
+			},
+
+			"fallback_public_client": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"public_client"},
+			},
+
+			// TODO: v2.0 remove this in favour of `web.0.homepage_url`
 			"homepage": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
+				Deprecated:       "This property is deprecated in favour of `web.0.homepage_url` and will be removed in version 2.0 of this provider.",
+				ConflictsWith:    []string{"web"},
 				ValidateDiagFunc: validate.URLIsHTTPOrHTTPS,
 			},
 
@@ -152,17 +228,39 @@ func applicationResource() *schema.Resource {
 				},
 			},
 
-			// TODO: v2.0 put this in a `web` block
+			"known_client_applications": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.UUID,
+				},
+			},
+
+			"logo_image": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				StateFunc:        applicationLogoImageStateFunc,
+				ValidateDiagFunc: validateApplicationLogoImage,
+			},
+
+			// TODO: v2.0 remove this in favour of `web.0.logout_url`
 			"logout_url": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				Computed:         true,
+				Deprecated:       "This property is deprecated in favour of `web.0.logout_url` and will be removed in version 2.0 of this provider.",
+				ConflictsWith:    []string{"web"},
 				ValidateDiagFunc: validate.URLIsHTTPOrHTTPS,
 			},
 
-			// TODO: v2.0 put this in an `implicit_grant` block and rename to `access_token_issuance_enabled`
+			// TODO: v2.0 remove this in favour of `web.0.implicit_grant.0.access_token_issuance_enabled`
 			"oauth2_allow_implicit_flow": {
-				Type:     schema.TypeBool,
-				Optional: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "This property is deprecated in favour of `web.0.implicit_grant.0.access_token_issuance_enabled` and will be removed in version 2.0 of this provider.",
+				ConflictsWith: []string{"web"},
 			},
 
 			// TODO: v2.0 put this in an `api` block and maybe rename to `oauth2_permission_scope`
@@ -254,23 +352,49 @@ func applicationResource() *schema.Resource {
 				},
 			},
 
-			// TODO: v2.0 rename this to `fallback_public_client` and remove Computed
+			// TODO: v2.0 remove this in favour of `fallback_public_client`
 			"public_client": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "This property is deprecated in favour of `fallback_public_client`, and will be removed in version 2.0 of this provider.",
+				ConflictsWith: []string{"fallback_public_client"},
+			},
+
+			"public_client_platform": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"reply_urls"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"redirect_uris": schemaRedirectUris(validate.RedirectUriForPublicClient),
+					},
+				},
 			},
 
+			// TODO: v2.0 remove this in favour of the `web`, `spa` and `public_client_platform` blocks
 			"reply_urls": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Deprecated:    "This property is deprecated in favour of the `redirect_uris` properties in the `web`, `spa` and `public_client_platform` blocks, and will be removed in version 2.0 of this provider.",
+				MaxItems:      validate.RedirectUriMaxCount,
+				ConflictsWith: []string{"public_client_platform", "spa", "web"},
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
 					ValidateDiagFunc: validate.NoEmptyStrings,
 				},
 			},
 
+			"requested_access_token_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2}),
+			},
+
 			"required_resource_access": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -307,6 +431,42 @@ func applicationResource() *schema.Resource {
 				},
 			},
 
+			"role_and_scope_ownership": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  applicationRoleAndScopeOwnershipAuthoritative,
+				ValidateFunc: validation.StringInSlice([]string{
+					applicationRoleAndScopeOwnershipAuthoritative,
+					applicationRoleAndScopeOwnershipDeclaredOnly,
+				}, false),
+			},
+
+			"sign_in_audience": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"available_to_other_tenants"},
+				ValidateFunc: validation.StringInSlice([]string{
+					signInAudienceMyOrg,
+					signInAudienceMultipleOrgs,
+					signInAudienceMultipleOrgsAndPersonal,
+					signInAudiencePersonal,
+				}, false),
+			},
+
+			"spa": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"reply_urls"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"redirect_uris": schemaRedirectUris(validate.RedirectUriForSpa),
+					},
+				},
+			},
+
 			// TODO: v2.0 drop this, there's no such distinction any more
 			"type": {
 				Type:         schema.TypeString,
@@ -316,6 +476,52 @@ func applicationResource() *schema.Resource {
 				Default:      "webapp/api",
 			},
 
+			"web": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"homepage", "logout_url", "oauth2_allow_implicit_flow", "reply_urls"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"homepage_url": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: validate.URLIsHTTPOrHTTPS,
+						},
+
+						"implicit_grant": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_token_issuance_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+
+									"id_token_issuance_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+
+						"logout_url": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validate.URLIsHTTPOrHTTPS,
+						},
+
+						"redirect_uris": schemaRedirectUris(validate.RedirectUriForWeb),
+					},
+				},
+			},
+
 			"application_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -326,10 +532,34 @@ func applicationResource() *schema.Resource {
 				Computed: true,
 			},
 
+			"template_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"adopt_existing"},
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"token_lifetime_policy_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"adopt_existing": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"prevent_duplicate_names", "template_id"},
+			},
+
 			"prevent_duplicate_names": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"adopt_existing"},
 			},
 		},
 	}
@@ -368,6 +598,9 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		if hasIdentUrls {
 			return tf.ErrorDiagPathF(nil, "identifier_uris", "Property is not required for a native application")
 		}
+		if d.Get("default_identifier_uri").(bool) {
+			return tf.ErrorDiagPathF(nil, "default_identifier_uri", "Property cannot be enabled for a native application")
+		}
 	}
 
 	// We don't send Oauth2Permissions here because applications tend to get a default `user_impersonation` scope
@@ -376,12 +609,27 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 	properties := graphrbac.ApplicationCreateParameters{
 		DisplayName:             &name,
 		IdentifierUris:          tf.ExpandStringSlicePtr(identUrls.([]interface{})),
-		ReplyUrls:               tf.ExpandStringSlicePtr(d.Get("reply_urls").(*schema.Set).List()),
-		AvailableToOtherTenants: utils.Bool(d.Get("available_to_other_tenants").(bool)),
+		KnownClientApplications: tf.ExpandStringSlicePtr(d.Get("known_client_applications").(*schema.Set).List()),
 		RequiredResourceAccess:  expandApplicationRequiredResourceAccessAad(d),
 		OptionalClaims:          expandApplicationOptionalClaimsAad(d),
 	}
 
+	// typed redirect URIs cannot be specified at creation time, so these are configured after the application
+	// has been created
+	redirectUrisConfigured := applicationRedirectUrisConfigured(d)
+	if !redirectUrisConfigured {
+		properties.ReplyUrls = tf.ExpandStringSlicePtr(d.Get("reply_urls").(*schema.Set).List())
+	}
+
+	// personal account audiences require v2 access tokens, which cannot be specified at creation time, so
+	// these are configured after the application has been created
+	signInAudience := d.Get("sign_in_audience").(string)
+	if signInAudience != "" && !signInAudienceIsPersonal(signInAudience) {
+		properties.SignInAudience = utils.String(signInAudience)
+	} else if signInAudience == "" {
+		properties.AvailableToOtherTenants = utils.Bool(d.Get("available_to_other_tenants").(bool))
+	}
+
 	if v, ok := d.GetOk("homepage"); ok {
 		properties.Homepage = utils.String(v.(string))
 	}
@@ -394,7 +642,21 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		properties.Oauth2AllowImplicitFlow = utils.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("public_client"); ok {
+	if v, ok := d.GetOk("web.0.homepage_url"); ok {
+		properties.Homepage = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("web.0.logout_url"); ok {
+		properties.LogoutURL = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("web.0.implicit_grant.0.access_token_issuance_enabled"); ok {
+		properties.Oauth2AllowImplicitFlow = utils.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("fallback_public_client"); ok {
+		properties.PublicClient = utils.Bool(v.(bool))
+	} else if v, ok := d.GetOk("public_client"); ok {
 		properties.PublicClient = utils.Bool(v.(bool))
 	}
 
@@ -402,9 +664,39 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		properties.GroupMembershipClaims = graphrbac.GroupMembershipClaimTypes(v.(string))
 	}
 
-	app, err := client.Create(ctx, properties)
-	if err != nil {
-		return tf.ErrorDiagF(err, "Could not create application")
+	var existingObjectId string
+	if d.Get("adopt_existing").(bool) {
+		objectIds, err := applicationFindIdsByDisplayName(ctx, client, name)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "display_name", "Could not check for existing application(s)")
+		}
+		switch len(objectIds) {
+		case 0:
+			log.Printf("[DEBUG] No existing application found with display name %q - creating a new application", name)
+		case 1:
+			existingObjectId = objectIds[0]
+		default:
+			return tf.ErrorDiagPathF(fmt.Errorf("found %d applications with display name %q: %s", len(objectIds), name, strings.Join(objectIds, ", ")), "display_name", "Could not adopt existing application")
+		}
+	}
+
+	var app graphrbac.Application
+	var err error
+	if existingObjectId != "" {
+		app, err = applicationAdoptExisting(ctx, client, existingObjectId, properties)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "adopt_existing", "Could not adopt existing application")
+		}
+	} else if v, ok := d.GetOk("template_id"); ok {
+		app, err = applicationInstantiateTemplate(ctx, d, meta, v.(string), properties)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "template_id", "Could not create application from template")
+		}
+	} else {
+		app, err = client.Create(ctx, properties)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not create application")
+		}
 	}
 	if app.ObjectID == nil || *app.ObjectID == "" {
 		return tf.ErrorDiagF(errors.New("Bad API response"), "Object ID returned for application is nil/empty")
@@ -433,10 +725,65 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if v, ok := d.GetOk("additional_properties"); ok {
+		additionalProperties, err := aadgraph.ExpandAdditionalProperties(v.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Parsing additional properties")
+		}
+		if _, err := aadgraph.ApplicationPatchProperties(ctx, client, *app.ObjectID, additionalProperties); err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Could not set additional properties")
+		}
+	}
+
+	// the default identifier URI is derived from the application ID, which is only known after creation
+	if d.Get("default_identifier_uri").(bool) {
+		if app.AppID == nil || *app.AppID == "" {
+			return tf.ErrorDiagF(errors.New("Bad API response"), "Application ID returned for application is nil/empty")
+		}
+		properties := graphrbac.ApplicationUpdateParameters{
+			IdentifierUris: expandApplicationIdentifierUris(d, *app.AppID),
+		}
+		if _, err := client.Patch(ctx, *app.ObjectID, properties); err != nil {
+			return tf.ErrorDiagPathF(err, "default_identifier_uri", "Could not set default identifier URI for application with object ID %q", *app.ObjectID)
+		}
+	}
+
+	if _, ok := d.GetOk("requested_access_token_version"); ok || signInAudienceIsPersonal(signInAudience) {
+		extraProperties := expandApplicationAudienceProperties(d)
+		if _, err := aadgraph.ApplicationPatchProperties(ctx, client, *app.ObjectID, extraProperties); err != nil {
+			return tf.ErrorDiagPathF(err, "sign_in_audience", "Could not set sign-in audience and access token version")
+		}
+	}
+
+	webProperties := make(map[string]interface{})
+	if redirectUrisConfigured {
+		webProperties["replyUrlsWithType"] = expandApplicationReplyUrlsWithType(d)
+	}
+	if v, ok := d.GetOk("web.0.implicit_grant.0.id_token_issuance_enabled"); ok {
+		webProperties["oauth2AllowIdTokenImplicitFlow"] = v.(bool)
+	}
+	if len(webProperties) > 0 {
+		if _, err := aadgraph.ApplicationPatchProperties(ctx, client, *app.ObjectID, webProperties); err != nil {
+			return tf.ErrorDiagPathF(err, "web", "Could not set redirect URIs and implicit grant settings")
+		}
+	}
+
+	declaredOnly := d.Get("role_and_scope_ownership").(string) == applicationRoleAndScopeOwnershipDeclaredOnly
+	if declaredOnly {
+		// the declared app roles and permission scopes are merged with those managed by the standalone resources
+		tf.LockByName(resourceApplicationName, *app.ObjectID)
+		defer tf.UnlockByName(resourceApplicationName, *app.ObjectID)
+	}
+
 	if v, ok := d.GetOk("app_role"); ok {
 		appRoles := expandApplicationAppRolesAad(v)
 		if appRoles != nil {
-			if err := aadgraph.AppRolesSet(ctx, client, *app.ObjectID, appRoles); err != nil {
+			if declaredOnly {
+				err = aadgraph.AppRolesSetDeclared(ctx, client, *app.ObjectID, appRoles, nil)
+			} else {
+				err = aadgraph.AppRolesSet(ctx, client, *app.ObjectID, appRoles)
+			}
+			if err != nil {
 				return tf.ErrorDiagPathF(err, "app_role", "Could not set App Roles")
 			}
 		}
@@ -445,7 +792,12 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 	if v, ok := d.GetOk("oauth2_permissions"); ok {
 		oauth2Permissions := expandApplicationOAuth2PermissionsAad(v)
 		if oauth2Permissions != nil {
-			if err := aadgraph.OAuth2PermissionsSet(ctx, client, *app.ObjectID, oauth2Permissions); err != nil {
+			if declaredOnly {
+				err = aadgraph.OAuth2PermissionsSetDeclared(ctx, client, *app.ObjectID, oauth2Permissions, nil)
+			} else {
+				err = aadgraph.OAuth2PermissionsSet(ctx, client, *app.ObjectID, oauth2Permissions)
+			}
+			if err != nil {
 				return tf.ErrorDiagPathF(err, "oauth2_permissions", "Could not set OAuth2 Permissions")
 			}
 		}
@@ -456,6 +808,20 @@ func applicationResourceCreate(ctx context.Context, d *schema.ResourceData, meta
 		if err := aadgraph.ApplicationSetOwnersTo(ctx, client, *app.ObjectID, desiredOwners); err != nil {
 			return tf.ErrorDiagPathF(err, "owners", "Could not set Owners")
 		}
+	} else if c := meta.(*clients.Client); c.AddCallerAsOwner && c.ObjectID != "" {
+		existingOwners, err := aadgraph.ApplicationAllOwners(ctx, client, *app.ObjectID)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for application with object ID %q", *app.ObjectID)
+		}
+		if err := aadgraph.ApplicationAddOwners(ctx, client, *app.ObjectID, utils.Difference([]string{c.ObjectID}, existingOwners)); err != nil {
+			return tf.ErrorDiagPathF(err, "owners", "Could not add the authenticated principal as an owner")
+		}
+	}
+
+	if v, ok := d.GetOk("logo_image"); ok {
+		if diags := applicationUploadLogoImage(ctx, client, *app.ObjectID, v.(string)); diags.HasError() {
+			return diags
+		}
 	}
 
 	return applicationResourceRead(ctx, d, meta)
@@ -494,31 +860,45 @@ func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		properties.DisplayName = &name
 	}
 
-	if d.HasChange("homepage") {
+	if d.HasChange("web.0.homepage_url") {
+		properties.Homepage = utils.String(d.Get("web.0.homepage_url").(string))
+	} else if d.HasChange("homepage") {
 		properties.Homepage = utils.String(d.Get("homepage").(string))
 	}
 
-	if d.HasChange("logout_url") {
+	if d.HasChange("web.0.logout_url") {
+		properties.LogoutURL = utils.String(d.Get("web.0.logout_url").(string))
+	} else if d.HasChange("logout_url") {
 		properties.LogoutURL = utils.String(d.Get("logout_url").(string))
 	}
 
-	if d.HasChange("identifier_uris") {
-		properties.IdentifierUris = tf.ExpandStringSlicePtr(d.Get("identifier_uris").([]interface{}))
+	if d.HasChange("identifier_uris") || d.HasChange("default_identifier_uri") {
+		properties.IdentifierUris = expandApplicationIdentifierUris(d, d.Get("application_id").(string))
+	}
+
+	if d.HasChange("known_client_applications") {
+		properties.KnownClientApplications = tf.ExpandStringSlicePtr(d.Get("known_client_applications").(*schema.Set).List())
 	}
 
 	if d.HasChange("reply_urls") {
 		properties.ReplyUrls = tf.ExpandStringSlicePtr(d.Get("reply_urls").(*schema.Set).List())
 	}
 
-	if d.HasChange("available_to_other_tenants") {
+	// `sign_in_audience` is always populated after the application has been read, so the deprecated property is
+	// updated whenever it changes on its own. The two properties conflict, so they cannot both be configured.
+	if d.HasChange("available_to_other_tenants") && !d.HasChange("sign_in_audience") {
 		properties.AvailableToOtherTenants = utils.Bool(d.Get("available_to_other_tenants").(bool))
 	}
 
-	if d.HasChange("oauth2_allow_implicit_flow") {
+	if d.HasChange("web.0.implicit_grant.0.access_token_issuance_enabled") {
+		properties.Oauth2AllowImplicitFlow = utils.Bool(d.Get("web.0.implicit_grant.0.access_token_issuance_enabled").(bool))
+	} else if d.HasChange("oauth2_allow_implicit_flow") {
 		properties.Oauth2AllowImplicitFlow = utils.Bool(d.Get("oauth2_allow_implicit_flow").(bool))
 	}
 
-	if d.HasChange("public_client") {
+	if d.HasChange("fallback_public_client") {
+		properties.PublicClient = utils.Bool(d.Get("fallback_public_client").(bool))
+	} else if d.HasChange("public_client") {
 		properties.PublicClient = utils.Bool(d.Get("public_client").(bool))
 	}
 
@@ -539,8 +919,11 @@ func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		switch appType := d.Get("type"); appType {
 		case "webapp/api":
 			properties.PublicClient = utils.Bool(false)
-			properties.IdentifierUris = tf.ExpandStringSlicePtr(d.Get("identifier_uris").([]interface{}))
+			properties.IdentifierUris = expandApplicationIdentifierUris(d, d.Get("application_id").(string))
 		case "native":
+			if d.Get("default_identifier_uri").(bool) {
+				return tf.ErrorDiagPathF(nil, "default_identifier_uri", "Property cannot be enabled for a native application")
+			}
 			properties.PublicClient = utils.Bool(true)
 			properties.IdentifierUris = &[]string{}
 		default:
@@ -553,10 +936,55 @@ func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return tf.ErrorDiagF(err, "Updating Application with object ID %q", d.Id())
 	}
 
+	// the sign-in audience and access token version are updated together, since personal account audiences require v2 access tokens
+	if d.HasChange("sign_in_audience") || d.HasChange("requested_access_token_version") {
+		extraProperties := expandApplicationAudienceProperties(d)
+		if _, err := aadgraph.ApplicationPatchProperties(ctx, client, d.Id(), extraProperties); err != nil {
+			return tf.ErrorDiagPathF(err, "sign_in_audience", "Could not set sign-in audience and access token version")
+		}
+	}
+
+	webProperties := make(map[string]interface{})
+	if d.HasChanges("public_client_platform.0.redirect_uris", "spa.0.redirect_uris", "web.0.redirect_uris") {
+		webProperties["replyUrlsWithType"] = expandApplicationReplyUrlsWithType(d)
+	}
+	if d.HasChange("web.0.implicit_grant.0.id_token_issuance_enabled") {
+		webProperties["oauth2AllowIdTokenImplicitFlow"] = d.Get("web.0.implicit_grant.0.id_token_issuance_enabled").(bool)
+	}
+	if len(webProperties) > 0 {
+		if _, err := aadgraph.ApplicationPatchProperties(ctx, client, d.Id(), webProperties); err != nil {
+			return tf.ErrorDiagPathF(err, "web", "Could not set redirect URIs and implicit grant settings")
+		}
+	}
+
+	if d.HasChange("additional_properties") {
+		oldProps, newProps := d.GetChange("additional_properties")
+		additionalProperties, err := aadgraph.ExpandAdditionalPropertiesChange(oldProps.(string), newProps.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Parsing additional properties")
+		}
+		if _, err := aadgraph.ApplicationPatchProperties(ctx, client, d.Id(), additionalProperties); err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Could not set additional properties")
+		}
+	}
+
+	declaredOnly := d.Get("role_and_scope_ownership").(string) == applicationRoleAndScopeOwnershipDeclaredOnly
+	if declaredOnly && d.HasChanges("app_role", "oauth2_permissions") {
+		// the declared app roles and permission scopes are merged with those managed by the standalone resources
+		tf.LockByName(resourceApplicationName, d.Id())
+		defer tf.UnlockByName(resourceApplicationName, d.Id())
+	}
+
 	if d.HasChange("app_role") {
 		appRoles := expandApplicationAppRolesAad(d.Get("app_role"))
 		if appRoles != nil {
-			if err := aadgraph.AppRolesSet(ctx, client, d.Id(), appRoles); err != nil {
+			var err error
+			if declaredOnly {
+				err = aadgraph.AppRolesSetDeclared(ctx, client, d.Id(), appRoles, applicationPreviouslyDeclaredIds(d, "app_role"))
+			} else {
+				err = aadgraph.AppRolesSet(ctx, client, d.Id(), appRoles)
+			}
+			if err != nil {
 				return tf.ErrorDiagPathF(err, "app_role", "Could not set App Roles")
 			}
 		}
@@ -565,7 +993,13 @@ func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if d.HasChange("oauth2_permissions") {
 		oauth2Permissions := expandApplicationOAuth2PermissionsAad(d.Get("oauth2_permissions"))
 		if oauth2Permissions != nil {
-			if err := aadgraph.OAuth2PermissionsSet(ctx, client, d.Id(), oauth2Permissions); err != nil {
+			var err error
+			if declaredOnly {
+				err = aadgraph.OAuth2PermissionsSetDeclared(ctx, client, d.Id(), oauth2Permissions, applicationPreviouslyDeclaredIds(d, "oauth2_permissions"))
+			} else {
+				err = aadgraph.OAuth2PermissionsSet(ctx, client, d.Id(), oauth2Permissions)
+			}
+			if err != nil {
 				return tf.ErrorDiagPathF(err, "oauth2_permissions", "Could not set OAuth2 Permissions")
 			}
 		}
@@ -573,11 +1007,34 @@ func applicationResourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("owners") {
 		desiredOwners := *tf.ExpandStringSlicePtr(d.Get("owners").(*schema.Set).List())
+
+		// the desired owners may not have been known at plan time, so check again against the current owners
+		if c := meta.(*clients.Client); !c.AllowOwnerSelfRemoval {
+			existingOwners, err := aadgraph.ApplicationAllOwners(ctx, client, d.Id())
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for application with object ID %q", d.Id())
+			}
+			if err := aadgraph.OwnersCheckSelfLockout(c.ObjectID, existingOwners, desiredOwners); err != nil {
+				return tf.ErrorDiagPathF(err, "owners", "Cannot update owners for application with object ID %q. To allow this, set `allow_owner_self_removal` in the provider configuration", d.Id())
+			}
+		}
+
 		if err := aadgraph.ApplicationSetOwnersTo(ctx, client, d.Id(), desiredOwners); err != nil {
 			return tf.ErrorDiagPathF(err, "owners", "Could not set Owners")
 		}
 	}
 
+	// the hash in state changes whenever the image content changes, or the uploaded logo has drifted from the configured image
+	if d.HasChange("logo_image") {
+		if v := d.Get("logo_image").(string); v != "" {
+			if diags := applicationUploadLogoImage(ctx, client, d.Id(), v); diags.HasError() {
+				return diags
+			}
+		} else if resp, err := aadgraph.ApplicationLogoDelete(ctx, client, d.Id()); err != nil && !utils.ResponseWasNotFound(resp) {
+			return tf.ErrorDiagPathF(err, "logo_image", "Could not remove logo for application with object ID %q", d.Id())
+		}
+	}
+
 	return applicationResourceRead(ctx, d, meta)
 }
 
@@ -595,22 +1052,63 @@ func applicationResourceRead(ctx context.Context, d *schema.ResourceData, meta i
 		return tf.ErrorDiagPathF(err, "id", "Retrieving Application with object ID %q", d.Id())
 	}
 
-	tf.Set(d, "app_role", aadgraph.FlattenAppRoles(app.AppRoles))
+	var diags diag.Diagnostics
+
+	appRoles := aadgraph.FlattenAppRoles(app.AppRoles)
+	oauth2Permissions := aadgraph.FlattenOauth2Permissions(app.Oauth2Permissions)
+
+	ownership := d.Get("role_and_scope_ownership").(string)
+	if ownership == "" {
+		ownership = applicationRoleAndScopeOwnershipAuthoritative
+	}
+
+	if ownership == applicationRoleAndScopeOwnershipDeclaredOnly {
+		appRoles = applicationFilterDeclared(appRoles, d.Get("app_role").(*schema.Set).List())
+		oauth2Permissions = applicationFilterDeclared(oauth2Permissions, d.Get("oauth2_permissions").(*schema.Set).List())
+	} else {
+		diags = append(diags, applicationUndeclaredWarnings("app_role", "azuread_application_app_role", appRoles, d.Get("app_role").(*schema.Set).List())...)
+		diags = append(diags, applicationUndeclaredWarnings("oauth2_permissions", "azuread_application_oauth2_permission", oauth2Permissions, d.Get("oauth2_permissions").(*schema.Set).List())...)
+	}
+
+	additionalProperties := ""
+	if v := d.Get("additional_properties").(string); v != "" {
+		properties, err := aadgraph.ApplicationObjectProperties(ctx, client, d.Id())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Retrieving additional properties for application with object ID %q", d.Id())
+		}
+		if additionalProperties, err = aadgraph.FlattenAdditionalProperties(properties, v); err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Flattening additional properties for application with object ID %q", d.Id())
+		}
+	}
+
+	tf.Set(d, "additional_properties", additionalProperties)
+	tf.Set(d, "app_role", appRoles)
 	tf.Set(d, "application_id", app.AppID)
 	tf.Set(d, "available_to_other_tenants", app.AvailableToOtherTenants)
 	tf.Set(d, "display_name", app.DisplayName)
+	tf.Set(d, "fallback_public_client", app.PublicClient)
+	tf.Set(d, "public_client", app.PublicClient)
 	tf.Set(d, "group_membership_claims", app.GroupMembershipClaims)
 	tf.Set(d, "homepage", app.Homepage)
-	tf.Set(d, "identifier_uris", tf.FlattenStringSlicePtr(app.IdentifierUris))
+	tf.Set(d, "default_identifier_uri", flattenApplicationDefaultIdentifierUri(d, app))
+	tf.Set(d, "identifier_uris", flattenApplicationIdentifierUris(d, app))
+	tf.Set(d, "known_client_applications", tf.FlattenStringSlicePtr(app.KnownClientApplications))
 	tf.Set(d, "logout_url", app.LogoutURL)
 	tf.Set(d, "name", app.DisplayName)
 	tf.Set(d, "oauth2_allow_implicit_flow", app.Oauth2AllowImplicitFlow)
-	tf.Set(d, "oauth2_permissions", aadgraph.FlattenOauth2Permissions(app.Oauth2Permissions))
+	tf.Set(d, "oauth2_permissions", oauth2Permissions)
 	tf.Set(d, "object_id", app.ObjectID)
 	tf.Set(d, "optional_claims", flattenApplicationOptionalClaimsAad(app.OptionalClaims))
-	tf.Set(d, "public_client", app.PublicClient)
 	tf.Set(d, "reply_urls", tf.FlattenStringSlicePtr(app.ReplyUrls))
+	tf.Set(d, "requested_access_token_version", flattenApplicationAccessTokenVersion(app))
 	tf.Set(d, "required_resource_access", flattenApplicationRequiredResourceAccessAad(app.RequiredResourceAccess))
+	tf.Set(d, "role_and_scope_ownership", ownership)
+	tf.Set(d, "sign_in_audience", app.SignInAudience)
+
+	webRedirectUris, spaRedirectUris, publicClientRedirectUris := flattenApplicationRedirectUris(app)
+	tf.Set(d, "public_client_platform", flattenApplicationRedirectUrisBlock(publicClientRedirectUris))
+	tf.Set(d, "spa", flattenApplicationRedirectUrisBlock(spaRedirectUris))
+	tf.Set(d, "web", flattenApplicationWeb(app, webRedirectUris))
 
 	var appType string
 	if v := app.PublicClient; v != nil && *v {
@@ -626,13 +1124,33 @@ func applicationResourceRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 	tf.Set(d, "owners", owners)
 
+	if diags := applicationReadAssignedPolicies(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+
+	// the logo is only retrieved when configured, so that drift can be detected without an extra request otherwise
+	if _, ok := d.GetOk("logo_image"); ok {
+		logo, resp, err := aadgraph.ApplicationLogoGet(ctx, client, d.Id())
+		if err != nil && !utils.ResponseWasNotFound(resp) {
+			return tf.ErrorDiagPathF(err, "logo_image", "Could not retrieve logo for application with object ID %q", d.Id())
+		}
+		tf.Set(d, "logo_image", applicationLogoImageHash(logo))
+	}
+
 	preventDuplicates := false
 	if v := d.Get("prevent_duplicate_names").(bool); v {
 		preventDuplicates = v
 	}
 	tf.Set(d, "prevent_duplicate_names", preventDuplicates)
+	tf.Set(d, "adopt_existing", d.Get("adopt_existing").(bool))
 
-	return nil
+	destroyBehavior := d.Get("destroy_behavior").(string)
+	if destroyBehavior == "" {
+		destroyBehavior = applicationDestroyBehaviorSoftDelete
+	}
+	tf.Set(d, "destroy_behavior", destroyBehavior)
+
+	return diags
 }
 
 func applicationResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -651,6 +1169,7 @@ func applicationResourceDelete(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	log.Printf("[INFO] Deleting Application with object ID %q", d.Id())
 	resp, err := client.Delete(ctx, d.Id())
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -658,290 +1177,1445 @@ func applicationResourceDelete(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	return nil
-}
+	if d.Get("destroy_behavior").(string) == applicationDestroyBehaviorHardDelete {
+		log.Printf("[INFO] Permanently deleting Application with object ID %q", d.Id())
+		deletedItemsClient := meta.(*clients.Client).Applications.DeletedItemsClient
 
-func expandApplicationRequiredResourceAccessAad(d *schema.ResourceData) *[]graphrbac.RequiredResourceAccess {
-	requiredResourcesAccesses := d.Get("required_resource_access").(*schema.Set).List()
-	result := make([]graphrbac.RequiredResourceAccess, 0)
+		// the application may take some time to appear in the deleted items
+		if _, err := aadgraph.WaitForCreationReplication(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+			item, err := deletedItemsClient.Get(ctx, d.Id())
+			return item.Response, err
+		}); err != nil {
+			return tf.ErrorDiagPathF(err, "destroy_behavior", "Waiting for deleted Application with object ID %q", d.Id())
+		}
 
-	for _, raw := range requiredResourcesAccesses {
-		requiredResourceAccess := raw.(map[string]interface{})
-		resource_app_id := requiredResourceAccess["resource_app_id"].(string)
+		resp, err := deletedItemsClient.PermanentlyDelete(ctx, d.Id())
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return tf.ErrorDiagPathF(err, "destroy_behavior", "Permanently deleting Application with object ID %q", d.Id())
+			}
+		}
 
-		result = append(result,
-			graphrbac.RequiredResourceAccess{
-				ResourceAppID: &resource_app_id,
-				ResourceAccess: expandApplicationResourceAccessAad(
-					requiredResourceAccess["resource_access"].([]interface{}),
-				),
-			},
-		)
+		return tf.DestroyBehaviorDiag(resourceApplicationName, d.Id(), applicationDestroyBehaviorHardDelete, "permanently deleted, and cannot be restored")
 	}
-	return &result
+
+	return nil
 }
 
-func expandApplicationResourceAccessAad(in []interface{}) *[]graphrbac.ResourceAccess {
-	resourceAccesses := make([]graphrbac.ResourceAccess, 0, len(in))
-	for _, resourceAccessRaw := range in {
-		resourceAccess := resourceAccessRaw.(map[string]interface{})
+// applicationResourceImportResolve resolves the ID specified for an import, which can be the object ID, the application
+// ID or an identifier URI of the application, to the object ID
+func applicationResourceImportResolve(ctx context.Context, id string, meta interface{}) (string, error) {
+	client := meta.(*clients.Client).Applications.AadClient
 
-		resourceId := resourceAccess["id"].(string)
-		resourceType := resourceAccess["type"].(string)
+	var filter string
+	if _, err := uuid.ParseUUID(id); err == nil {
+		app, err := client.Get(ctx, id)
+		if err == nil {
+			return id, nil
+		}
+		if !utils.ResponseWasNotFound(app.Response) {
+			return "", fmt.Errorf("retrieving application with object ID %q: %+v", id, err)
+		}
 
-		resourceAccesses = append(resourceAccesses,
-			graphrbac.ResourceAccess{
-				ID:   &resourceId,
-				Type: &resourceType,
-			},
-		)
+		// not an object ID, so look for an application with this application ID
+		filter = fmt.Sprintf("appId eq '%s'", id)
+	} else if strings.Contains(id, ":") {
+		filter = fmt.Sprintf("identifierUris/any(s:s eq '%s')", aadgraph.ODataString(id))
+	} else {
+		return "", fmt.Errorf("specified ID (%q) is not a valid object ID, application ID or identifier URI", id)
 	}
 
-	return &resourceAccesses
-}
-
-func flattenApplicationRequiredResourceAccessAad(in *[]graphrbac.RequiredResourceAccess) []map[string]interface{} {
-	if in == nil {
-		return []map[string]interface{}{}
+	result, err := client.ListComplete(ctx, filter)
+	if err != nil {
+		return "", fmt.Errorf("listing applications for filter %q: %+v", filter, err)
 	}
 
-	result := make([]map[string]interface{}, 0, len(*in))
-	for _, requiredResourceAccess := range *in {
-		resource := make(map[string]interface{})
-		if requiredResourceAccess.ResourceAppID != nil {
-			resource["resource_app_id"] = *requiredResourceAccess.ResourceAppID
+	objectIds := make([]string, 0)
+	for result.NotDone() {
+		if app := result.Value(); app.ObjectID != nil {
+			objectIds = append(objectIds, *app.ObjectID)
 		}
 
-		resource["resource_access"] = flattenApplicationResourceAccessAad(requiredResourceAccess.ResourceAccess)
+		if err := result.NextWithContext(ctx); err != nil {
+			return "", fmt.Errorf("listing applications for filter %q: %+v", filter, err)
+		}
+	}
 
-		result = append(result, resource)
+	switch len(objectIds) {
+	case 0:
+		return "", fmt.Errorf("no application found with object ID, application ID or identifier URI %q", id)
+	case 1:
+		return objectIds[0], nil
 	}
 
-	return result
+	return "", fmt.Errorf("found %d applications matching %q", len(objectIds), id)
 }
 
-func flattenApplicationResourceAccessAad(in *[]graphrbac.ResourceAccess) []interface{} {
-	if in == nil {
-		return []interface{}{}
+func applicationResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := applicationValidateSignInAudience(diff); err != nil {
+		return err
 	}
 
-	accesses := make([]interface{}, 0)
-	for _, resourceAccess := range *in {
-		access := make(map[string]interface{})
-		if resourceAccess.ID != nil {
-			access["id"] = *resourceAccess.ID
-		}
-		if resourceAccess.Type != nil {
-			access["type"] = *resourceAccess.Type
-		}
-		accesses = append(accesses, access)
+	if err := applicationValidateRoleAndScopeOwnership(diff); err != nil {
+		return err
 	}
 
-	return accesses
+	if err := applicationValidateRolesScopesDiff(diff); err != nil {
+		return err
+	}
+
+	if err := applicationValidateNativeType(diff); err != nil {
+		return err
+	}
+
+	if err := applicationValidateIdentifierUriDomains(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	if err := applicationValidateReplyUrls(diff); err != nil {
+		return err
+	}
+
+	if err := applicationValidateRedirectUriCount(diff); err != nil {
+		return err
+	}
+
+	if err := applicationValidateOwners(diff, meta); err != nil {
+		return err
+	}
+
+	return nil
 }
 
-func expandApplicationOptionalClaimsAad(d *schema.ResourceData) *graphrbac.OptionalClaims {
-	result := graphrbac.OptionalClaims{}
+// applicationValidateOwners prevents changes to `owners` which would remove the authenticated principal, or all owners,
+// from an existing application unless this has been explicitly allowed in the provider configuration
+func applicationValidateOwners(diff *schema.ResourceDiff, meta interface{}) error {
+	c := meta.(*clients.Client)
+	if c.AllowOwnerSelfRemoval || diff.Id() == "" || !diff.HasChange("owners") || !diff.NewValueKnown("owners") {
+		return nil
+	}
 
-	for _, raw := range d.Get("optional_claims").([]interface{}) {
-		optionalClaims := raw.(map[string]interface{})
-		result.AccessToken = expandApplicationOptionalClaimAad(optionalClaims["access_token"].([]interface{}))
-		result.IDToken = expandApplicationOptionalClaimAad(optionalClaims["id_token"].([]interface{}))
-		// TODO: enable when https://github.com/Azure/azure-sdk-for-go/issues/9714 resolved
-		//result.SamlToken = expandApplicationOptionalClaim(optionalClaims["saml2_token"].([]interface{}))
+	oldOwners, newOwners := diff.GetChange("owners")
+	existing := *tf.ExpandStringSlicePtr(oldOwners.(*schema.Set).List())
+	desired := *tf.ExpandStringSlicePtr(newOwners.(*schema.Set).List())
+	if err := aadgraph.OwnersCheckSelfLockout(c.ObjectID, existing, desired); err != nil {
+		return fmt.Errorf("cannot update `owners`: %+v. To allow this, set `allow_owner_self_removal` in the provider configuration", err)
 	}
-	return &result
+
+	return nil
 }
 
-func expandApplicationOptionalClaimAad(in []interface{}) *[]graphrbac.OptionalClaim {
-	optionalClaims := make([]graphrbac.OptionalClaim, 0, len(in))
-	for _, optionalClaimRaw := range in {
-		optionalClaim := optionalClaimRaw.(map[string]interface{})
+// applicationValidateReplyUrls checks the deprecated `reply_urls` property against the redirect URI rules for the web
+// platform, or for the public client platform in the case of native applications, whose reply URLs are used by public
+// clients. Since `reply_urls` is computed from the redirect URIs for all platforms, it's only checked when changed.
+func applicationValidateReplyUrls(diff *schema.ResourceDiff) error {
+	if !diff.HasChange("reply_urls") || !diff.NewValueKnown("reply_urls") {
+		return nil
+	}
 
-		name := optionalClaim["name"].(string)
-		essential := optionalClaim["essential"].(bool)
-		additionalProps := make([]string, 0)
+	validateFunc := validate.RedirectUriForWeb
+	if diff.Get("type").(string) == "native" {
+		validateFunc = validate.RedirectUriForPublicClient
+	}
 
-		if props := optionalClaim["additional_properties"]; props != nil {
-			for _, prop := range props.([]interface{}) {
-				additionalProps = append(additionalProps, prop.(string))
+	for _, raw := range diff.Get("reply_urls").(*schema.Set).List() {
+		for _, d := range validateFunc(raw, cty.GetAttrPath("reply_urls")) {
+			if d.Severity == diag.Error {
+				return fmt.Errorf("invalid value in `reply_urls`: %s. %s", d.Summary, d.Detail)
 			}
 		}
+	}
 
-		newClaim := graphrbac.OptionalClaim{
-			Name:                 &name,
-			Essential:            &essential,
-			AdditionalProperties: &additionalProps,
+	return nil
+}
+
+// applicationValidateRedirectUriCount checks that the total number of redirect URIs across all platforms does not
+// exceed the limit imposed by the API
+func applicationValidateRedirectUriCount(diff *schema.ResourceDiff) error {
+	count := 0
+	for _, attr := range []string{"public_client_platform.0.redirect_uris", "spa.0.redirect_uris", "web.0.redirect_uris"} {
+		if !diff.NewValueKnown(attr) {
+			return nil
+		}
+		if v, ok := diff.Get(attr).(*schema.Set); ok {
+			count += v.Len()
 		}
+	}
 
-		if source := optionalClaim["source"].(string); source != "" {
-			newClaim.Source = &source
+	// `reply_urls` is computed from the redirect URIs for all platforms, so it's only counted when it's being used
+	// instead of the platform blocks
+	if count == 0 {
+		if !diff.NewValueKnown("reply_urls") {
+			return nil
 		}
+		if v, ok := diff.Get("reply_urls").(*schema.Set); ok {
+			count = v.Len()
+		}
+	}
 
-		optionalClaims = append(optionalClaims, newClaim)
+	if count > validate.RedirectUriMaxCount {
+		return fmt.Errorf("at most %d redirect URIs can be specified across the `public_client_platform`, `spa` and `web` blocks, or in the `reply_urls` property, got %d", validate.RedirectUriMaxCount, count)
 	}
 
-	return &optionalClaims
+	return nil
 }
 
-func flattenApplicationOptionalClaimsAad(in *graphrbac.OptionalClaims) interface{} {
-	var result []map[string]interface{}
-
-	if in == nil {
-		return result
+// applicationValidateRolesScopesDiff checks for duplicate app role and permission scope values, which are otherwise
+// only rejected when the application is created or updated
+func applicationValidateRolesScopesDiff(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("app_role") || !diff.NewValueKnown("oauth2_permissions") {
+		return nil
 	}
 
-	optionalClaims := make(map[string]interface{})
-	if claims := flattenApplicationOptionalClaimsListAad(in.AccessToken); len(claims) > 0 {
-		optionalClaims["access_token"] = claims
+	return applicationValidateRolesScopes(diff.Get("app_role").(*schema.Set).List(), diff.Get("oauth2_permissions").(*schema.Set).List())
+}
+
+// applicationValidateNativeType enforces the restrictions for native applications, which cannot have identifier URIs
+func applicationValidateNativeType(diff *schema.ResourceDiff) error {
+	if diff.Get("type").(string) != "native" {
+		return nil
 	}
-	if claims := flattenApplicationOptionalClaimsListAad(in.IDToken); len(claims) > 0 {
-		optionalClaims["id_token"] = claims
+
+	if diff.NewValueKnown("identifier_uris") && len(diff.Get("identifier_uris").([]interface{})) > 0 && diff.HasChange("identifier_uris") {
+		return fmt.Errorf("`identifier_uris` is not required for a native application and cannot be specified")
 	}
-	// TODO: enable when https://github.com/Azure/azure-sdk-for-go/issues/9714 resolved
-	//if claims := flattenApplicationOptionalClaimsList(in.SamlToken); len(claims) > 0 {
-	//	optionalClaims["saml_token"] = claims
-	//}
-	if len(optionalClaims) == 0 {
-		return result
+
+	if diff.Get("default_identifier_uri").(bool) {
+		return fmt.Errorf("`default_identifier_uri` cannot be enabled for a native application")
 	}
-	result = append(result, optionalClaims)
-	return result
+
+	return nil
 }
 
-func flattenApplicationOptionalClaimsListAad(in *[]graphrbac.OptionalClaim) []interface{} {
-	if in == nil {
-		return []interface{}{}
+// applicationValidateIdentifierUriDomains checks that HTTP(S) identifier URIs for multi-tenant applications use a
+// verified domain for the tenant, or a subdomain of one, as required by Azure Active Directory. Domains are not checked
+// when they cannot be listed, e.g. because the caller does not have permission to read them.
+func applicationValidateIdentifierUriDomains(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("identifier_uris") {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("identifier_uris") && !diff.HasChange("sign_in_audience") && !diff.HasChange("available_to_other_tenants") {
+		return nil
 	}
 
-	optionalClaims := make([]interface{}, 0)
-	for _, claim := range *in {
-		optionalClaim := make(map[string]interface{})
-		if claim.Name != nil {
-			optionalClaim["name"] = *claim.Name
+	// `sign_in_audience` is computed from state once the application exists, so a change to the deprecated
+	// `available_to_other_tenants` property takes precedence over it
+	if diff.Get("sign_in_audience").(string) == "" || (diff.HasChange("available_to_other_tenants") && !diff.HasChange("sign_in_audience")) {
+		if !diff.Get("available_to_other_tenants").(bool) {
+			return nil
 		}
-		if claim.Source != nil {
-			optionalClaim["source"] = *claim.Source
+	} else {
+		switch diff.Get("sign_in_audience").(string) {
+		case signInAudienceMultipleOrgs, signInAudienceMultipleOrgsAndPersonal:
+		default:
+			return nil
 		}
-		if claim.Essential != nil {
-			optionalClaim["essential"] = *claim.Essential
+	}
+
+	hosts := make([]string, 0)
+	uris := make([]string, 0)
+	for _, raw := range diff.Get("identifier_uris").([]interface{}) {
+		uri, ok := raw.(string)
+		if !ok {
+			continue
 		}
-		additionalProperties := make([]string, 0)
-		if props := claim.AdditionalProperties; props != nil {
-			for _, prop := range props.([]interface{}) {
-				additionalProperties = append(additionalProperties, prop.(string))
-			}
+		u, err := url.Parse(uri)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+			continue
 		}
-		optionalClaim["additional_properties"] = additionalProperties
-		optionalClaims = append(optionalClaims, optionalClaim)
+		hosts = append(hosts, u.Hostname())
+		uris = append(uris, uri)
+	}
+	if len(uris) == 0 {
+		return nil
 	}
 
-	return optionalClaims
-}
+	domains, err := meta.(*clients.Client).Domains.TenantDomains(ctx)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to validate the domains for identifier URIs: %v", err)
+		return nil
+	}
 
-func expandApplicationAppRolesAad(i interface{}) *[]graphrbac.AppRole {
-	input := i.(*schema.Set).List()
-	output := make([]graphrbac.AppRole, 0, len(input))
+	for i, host := range hosts {
+		if aadgraph.DomainFindVerifiedForHost(domains, host) == nil {
+			return fmt.Errorf("the identifier URI %q must use a verified domain for this tenant, or a subdomain of one, since the application is available to other tenants", uris[i])
+		}
+	}
 
-	for _, appRoleRaw := range input {
-		appRole := appRoleRaw.(map[string]interface{})
+	return nil
+}
 
-		appRoleID := appRole["id"].(string)
-		if appRoleID == "" {
-			appRoleID, _ = uuid.GenerateUUID()
-		}
+// applicationValidateSignInAudience enforces the restrictions that Azure Active Directory places on applications
+// which support personal Microsoft accounts, so that these can be surfaced at plan time
+func applicationValidateSignInAudience(diff *schema.ResourceDiff) error {
+	signInAudience := diff.Get("sign_in_audience").(string)
+	if !signInAudienceIsPersonal(signInAudience) {
+		return nil
+	}
 
-		var appRoleAllowedMemberTypes []string
-		for _, appRoleAllowedMemberType := range appRole["allowed_member_types"].(*schema.Set).List() {
-			appRoleAllowedMemberTypes = append(appRoleAllowedMemberTypes, appRoleAllowedMemberType.(string))
+	if diff.NewValueKnown("requested_access_token_version") {
+		if v := diff.Get("requested_access_token_version").(int); v != 0 && v != 2 {
+			// `requested_access_token_version` is computed, and is read as 1 when the API returns the default, so a
+			// value carried over from state is not necessarily configured. Only a value set for a new application,
+			// or changed in this plan, can have come from the configuration.
+			if diff.Id() == "" || diff.HasChange("requested_access_token_version") {
+				return fmt.Errorf("`requested_access_token_version` must be 2 when `sign_in_audience` is %q", signInAudience)
+			}
+			if err := diff.SetNew("requested_access_token_version", 2); err != nil {
+				return err
+			}
 		}
+	}
 
-		appRoleDescription := appRole["description"].(string)
-		appRoleDisplayName := appRole["display_name"].(string)
-		appRoleIsEnabled := appRole["is_enabled"].(bool)
-
-		var appRoleValue *string
-		if v, ok := appRole["value"].(string); ok {
-			appRoleValue = &v
+	if diff.NewValueKnown("identifier_uris") {
+		identifierUris := diff.Get("identifier_uris").([]interface{})
+		if len(identifierUris) > signInAudiencePersonalMaxIdentifierUris {
+			return fmt.Errorf("a maximum of %d `identifier_uris` can be specified when `sign_in_audience` is %q", signInAudiencePersonalMaxIdentifierUris, signInAudience)
 		}
 
-		output = append(output,
-			graphrbac.AppRole{
-				ID:                 &appRoleID,
-				AllowedMemberTypes: &appRoleAllowedMemberTypes,
-				Description:        &appRoleDescription,
-				DisplayName:        &appRoleDisplayName,
-				IsEnabled:          &appRoleIsEnabled,
-				Value:              appRoleValue,
-			},
-		)
+		for _, raw := range identifierUris {
+			uri, ok := raw.(string)
+			if !ok {
+				continue
+			}
+			if !strings.HasPrefix(uri, "api://") && !strings.HasPrefix(uri, "https://") {
+				return fmt.Errorf("`identifier_uris` must use the api:// or https:// scheme when `sign_in_audience` is %q, got %q", signInAudience, uri)
+			}
+			if strings.Contains(uri, "*") {
+				return fmt.Errorf("`identifier_uris` cannot contain wildcards when `sign_in_audience` is %q, got %q", signInAudience, uri)
+			}
+		}
 	}
 
-	return &output
+	return nil
 }
 
-func expandApplicationOAuth2PermissionsAad(i interface{}) *[]graphrbac.OAuth2Permission {
-	input := i.(*schema.Set).List()
-	result := make([]graphrbac.OAuth2Permission, 0)
-
-	for _, raw := range input {
-		OAuth2Permissions := raw.(map[string]interface{})
+// applicationValidateRoleAndScopeOwnership ensures that app roles and permission scopes declared inline can be
+// identified when only declared items are being managed, since their IDs are not known until after they are created
+func applicationValidateRoleAndScopeOwnership(diff *schema.ResourceDiff) error {
+	if diff.Get("role_and_scope_ownership").(string) != applicationRoleAndScopeOwnershipDeclaredOnly {
+		return nil
+	}
 
-		AdminConsentDescription := OAuth2Permissions["admin_consent_description"].(string)
-		AdminConsentDisplayName := OAuth2Permissions["admin_consent_display_name"].(string)
-		ID := OAuth2Permissions["id"].(string)
-		if ID == "" {
-			ID, _ = uuid.GenerateUUID()
+	for _, key := range []string{"app_role", "oauth2_permissions"} {
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+		for _, raw := range diff.Get(key).(*schema.Set).List() {
+			if v, ok := raw.(map[string]interface{}); ok && v["value"].(string) == "" {
+				return fmt.Errorf("all `%s` blocks must specify a `value` when `role_and_scope_ownership` is %q", key, applicationRoleAndScopeOwnershipDeclaredOnly)
+			}
 		}
+	}
 
-		IsEnabled := OAuth2Permissions["is_enabled"].(bool)
-		Type := OAuth2Permissions["type"].(string)
-		UserConsentDescription := OAuth2Permissions["user_consent_description"].(string)
-		UserConsentDisplayName := OAuth2Permissions["user_consent_display_name"].(string)
-		Value := OAuth2Permissions["value"].(string)
+	return nil
+}
 
-		result = append(result,
-			graphrbac.OAuth2Permission{
-				AdminConsentDescription: &AdminConsentDescription,
-				AdminConsentDisplayName: &AdminConsentDisplayName,
-				ID:                      &ID,
-				IsEnabled:               &IsEnabled,
-				Type:                    &Type,
-				UserConsentDescription:  &UserConsentDescription,
-				UserConsentDisplayName:  &UserConsentDisplayName,
-				Value:                   &Value,
-			},
-		)
+func applicationUploadLogoImage(ctx context.Context, client *graphrbac.ApplicationsClient, objectId, value string) diag.Diagnostics {
+	content, contentType, err := applicationLogoImage(value)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "logo_image", "Loading logo image")
 	}
-	return &result
+
+	if _, err := aadgraph.ApplicationLogoSet(ctx, client, objectId, contentType, content); err != nil {
+		return tf.ErrorDiagPathF(err, "logo_image", "Could not upload logo for application with object ID %q", objectId)
+	}
+
+	return nil
 }
 
-func applicationValidateRolesScopes(appRoles, oauth2Permissions []interface{}) error {
-	var values []string
+// applicationInstantiateTemplate creates an application and its service principal from the application template
+// with the specified ID, then applies the configured properties to the application. Properties which are not
+// configured retain the values provided by the template.
+func applicationInstantiateTemplate(ctx context.Context, d *schema.ResourceData, meta interface{}, templateId string, properties graphrbac.ApplicationCreateParameters) (graphrbac.Application, error) {
+	client := meta.(*clients.Client).Applications.AadClient
+	templatesClient := meta.(*clients.Client).Applications.ApplicationTemplatesClient
 
-	for _, roleRaw := range appRoles {
-		role := roleRaw.(map[string]interface{})
-		if val := role["value"].(string); val != "" {
-			values = append(values, val)
-		}
+	result, err := templatesClient.Instantiate(ctx, templateId, *properties.DisplayName)
+	if err != nil {
+		return graphrbac.Application{}, fmt.Errorf("instantiating application template %q: %+v", templateId, err)
 	}
+	if result.Application == nil || result.Application.ID == nil || *result.Application.ID == "" {
+		return graphrbac.Application{}, errors.New("object ID returned for instantiated application is nil/empty")
+	}
+	objectId := *result.Application.ID
 
-	for _, scopeRaw := range oauth2Permissions {
-		scope := scopeRaw.(map[string]interface{})
-		if val := scope["value"].(string); val != "" {
-			values = append(values, val)
-		}
+	// the service principal is recorded so that it can be adopted by an `azuread_service_principal` resource for this
+	// application, rather than being treated as a pre-existing service principal
+	if result.Application.AppID != nil && result.ServicePrincipal != nil && result.ServicePrincipal.ID != nil {
+		meta.(*clients.Client).RecordTemplateServicePrincipal(*result.Application.AppID, *result.ServicePrincipal.ID)
 	}
 
-	encountered := make([]string, len(values))
-	for _, val := range values {
-		for _, en := range encountered {
-			if en == val {
-				return fmt.Errorf("validation failed: duplicate value found: %q", val)
-			}
+	// the application and service principal now exist, so the ID is set in case any of the following steps fail,
+	// otherwise they would be orphaned and duplicated by the next apply
+	d.SetId(objectId)
+
+	// the application is created using Microsoft Graph, so we must wait for it to become available in Azure Active Directory Graph
+	_, err = aadgraph.WaitForCreationReplication(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return client.Get(ctx, objectId)
+	})
+	if err != nil {
+		return graphrbac.Application{}, fmt.Errorf("waiting for instantiated application with object ID %q: %+v", objectId, err)
+	}
+
+	update := graphrbac.ApplicationUpdateParameters{
+		AvailableToOtherTenants: properties.AvailableToOtherTenants,
+		GroupMembershipClaims:   properties.GroupMembershipClaims,
+		Homepage:                properties.Homepage,
+		LogoutURL:               properties.LogoutURL,
+		Oauth2AllowImplicitFlow: properties.Oauth2AllowImplicitFlow,
+		OptionalClaims:          properties.OptionalClaims,
+		PublicClient:            properties.PublicClient,
+		SignInAudience:          properties.SignInAudience,
+	}
+	if v := properties.IdentifierUris; v != nil && len(*v) > 0 {
+		update.IdentifierUris = v
+	}
+	if v := properties.KnownClientApplications; v != nil && len(*v) > 0 {
+		update.KnownClientApplications = v
+	}
+	if v := properties.ReplyUrls; v != nil && len(*v) > 0 {
+		update.ReplyUrls = v
+	}
+	if v := properties.RequiredResourceAccess; v != nil && len(*v) > 0 {
+		update.RequiredResourceAccess = v
+	}
+
+	if _, err := client.Patch(ctx, objectId, update); err != nil {
+		return graphrbac.Application{}, fmt.Errorf("updating instantiated application with object ID %q: %+v", objectId, err)
+	}
+
+	app, err := client.Get(ctx, objectId)
+	if err != nil {
+		return graphrbac.Application{}, fmt.Errorf("retrieving instantiated application with object ID %q: %+v", objectId, err)
+	}
+
+	return app, nil
+}
+
+// applicationAdoptExisting takes over an existing application and updates it with the properties that would otherwise
+// have been used to create the application. Properties which are not configured, and which are not computed, are
+// cleared. The remaining properties are reconciled in the same way as for a newly created application.
+func applicationAdoptExisting(ctx context.Context, client *graphrbac.ApplicationsClient, objectId string, properties graphrbac.ApplicationCreateParameters) (graphrbac.Application, error) {
+	log.Printf("[DEBUG] Adopting existing application with object ID %q", objectId)
+
+	update := graphrbac.ApplicationUpdateParameters{
+		AvailableToOtherTenants: properties.AvailableToOtherTenants,
+		DisplayName:             properties.DisplayName,
+		GroupMembershipClaims:   properties.GroupMembershipClaims,
+		Homepage:                properties.Homepage,
+		KnownClientApplications: properties.KnownClientApplications,
+		LogoutURL:               properties.LogoutURL,
+		Oauth2AllowImplicitFlow: properties.Oauth2AllowImplicitFlow,
+		OptionalClaims:          properties.OptionalClaims,
+		PublicClient:            properties.PublicClient,
+		RequiredResourceAccess:  properties.RequiredResourceAccess,
+		SignInAudience:          properties.SignInAudience,
+	}
+	if update.Oauth2AllowImplicitFlow == nil {
+		update.Oauth2AllowImplicitFlow = utils.Bool(false)
+	}
+	if v := properties.IdentifierUris; v != nil && len(*v) > 0 {
+		update.IdentifierUris = v
+	}
+	if v := properties.ReplyUrls; v != nil && len(*v) > 0 {
+		update.ReplyUrls = v
+	}
+
+	if _, err := client.Patch(ctx, objectId, update); err != nil {
+		return graphrbac.Application{}, fmt.Errorf("updating existing application with object ID %q: %+v", objectId, err)
+	}
+
+	// the SDK omits nil values, so the logout URL must be cleared separately
+	if properties.LogoutURL == nil {
+		if _, err := aadgraph.ApplicationPatchProperties(ctx, client, objectId, map[string]interface{}{"logoutUrl": nil}); err != nil {
+			return graphrbac.Application{}, fmt.Errorf("clearing logout URL for existing application with object ID %q: %+v", objectId, err)
 		}
-		encountered = append(encountered, val)
 	}
 
+	app, err := client.Get(ctx, objectId)
+	if err != nil {
+		return graphrbac.Application{}, fmt.Errorf("retrieving existing application with object ID %q: %+v", objectId, err)
+	}
+
+	return app, nil
+}
+
+// applicationFindIdsByDisplayName returns the object IDs of all applications with the specified display name
+func applicationFindIdsByDisplayName(ctx context.Context, client *graphrbac.ApplicationsClient, displayName string) ([]string, error) {
+	filter := fmt.Sprintf("displayName eq '%s'", aadgraph.ODataString(displayName))
+	result, err := client.ListComplete(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing applications for filter %q: %+v", filter, err)
+	}
+
+	objectIds := make([]string, 0)
+	for result.NotDone() {
+		app := result.Value()
+		if app.ObjectID != nil && app.DisplayName != nil && strings.EqualFold(*app.DisplayName, displayName) {
+			objectIds = append(objectIds, *app.ObjectID)
+		}
+
+		if err := result.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing applications for filter %q: %+v", filter, err)
+		}
+	}
+
+	return objectIds, nil
+}
+
+// applicationReadAssignedPolicies sets the IDs of policies assigned to the application. Policy assignments are only
+// available from Microsoft Graph, so these are left unchanged when Microsoft Graph is not available in the configured
+// environment, or when the caller does not have permission to read policies.
+func applicationReadAssignedPolicies(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.PoliciesClient
+
+	result, err := client.ListAssigned(ctx, msgraph.PolicyObjectTypeApplication, d.Id(), msgraph.PolicyTypeTokenLifetime)
+	if err != nil {
+		if err == msgraph.ErrNotAvailable || utils.ResponseWasStatusCode(result.Response, http.StatusForbidden) || utils.ResponseWasNotFound(result.Response) {
+			log.Printf("[DEBUG] Unable to list token lifetime policies for application with object ID %q: %v", d.Id(), err)
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "token_lifetime_policy_ids", "Listing token lifetime policies for application with object ID %q", d.Id())
+	}
+
+	tf.Set(d, "token_lifetime_policy_ids", msgraph.PolicyIDs(result))
+
 	return nil
 }
+
+// applicationPreviouslyDeclaredIds returns the IDs of the app roles or permission scopes previously declared inline,
+// which should be removed if no longer declared. These are only known when the previous ownership mode was also
+// `DeclaredOnly`, since the state will otherwise include roles or scopes which may be managed elsewhere.
+func applicationPreviouslyDeclaredIds(d *schema.ResourceData, key string) []string {
+	oldOwnership, _ := d.GetChange("role_and_scope_ownership")
+	if oldOwnership.(string) != applicationRoleAndScopeOwnershipDeclaredOnly {
+		return nil
+	}
+
+	old, _ := d.GetChange(key)
+	ids := make([]string, 0)
+	for _, raw := range old.(*schema.Set).List() {
+		if v, ok := raw.(map[string]interface{}); ok && v["id"].(string) != "" {
+			ids = append(ids, v["id"].(string))
+		}
+	}
+	return ids
+}
+
+// applicationDeclaredMatch determines whether a flattened app role or permission scope matches one of those declared
+// inline, either by ID or, since IDs are not known until after they are created, by value
+func applicationDeclaredMatch(item map[string]interface{}, declared []interface{}) bool {
+	id, _ := item["id"].(string)
+	value, _ := item["value"].(string)
+
+	for _, raw := range declared {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if declaredId, _ := v["id"].(string); declaredId != "" && declaredId == id {
+			return true
+		}
+		if declaredValue, _ := v["value"].(string); declaredValue != "" && declaredValue == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// applicationFilterDeclared returns only those app roles or permission scopes which are declared inline
+func applicationFilterDeclared(items []map[string]interface{}, declared []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	for _, item := range items {
+		if applicationDeclaredMatch(item, declared) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// applicationUndeclaredWarnings returns a warning for each app role or permission scope found on the application which
+// is not declared inline, which indicates that it is being managed by a separate resource and will be removed the next
+// time the inline blocks are applied. These warnings are emitted when the application is refreshed, since the roles
+// and scopes created by other resources are not known until then.
+func applicationUndeclaredWarnings(key, resourceType string, items []map[string]interface{}, declared []interface{}) (diags diag.Diagnostics) {
+	// nothing is declared, or this is a read following an apply, where the IDs of new items are not yet known
+	if len(declared) == 0 {
+		return
+	}
+	for _, raw := range declared {
+		if v, ok := raw.(map[string]interface{}); !ok || v["id"].(string) == "" {
+			return
+		}
+	}
+
+	for _, item := range items {
+		if applicationDeclaredMatch(item, declared) {
+			continue
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("`%s` with value %q is not declared in this resource", key, item["value"]),
+			Detail: fmt.Sprintf("The %s with ID %q was found on the application but is not declared in the `%s` blocks of this resource, "+
+				"and will be removed on the next apply. If it is managed using the `%s` resource, set "+
+				"`role_and_scope_ownership = %q` on this application so that it is preserved.",
+				key, item["id"], key, resourceType, applicationRoleAndScopeOwnershipDeclaredOnly),
+			AttributePath: cty.GetAttrPath(key),
+		})
+	}
+
+	return
+}
+
+func signInAudienceIsPersonal(signInAudience string) bool {
+	return signInAudience == signInAudienceMultipleOrgsAndPersonal || signInAudience == signInAudiencePersonal
+}
+
+func expandApplicationAudienceProperties(d *schema.ResourceData) map[string]interface{} {
+	result := map[string]interface{}{
+		"accessTokenAcceptedVersion": nil,
+	}
+
+	signInAudience := d.Get("sign_in_audience").(string)
+	if signInAudience != "" {
+		result["signInAudience"] = signInAudience
+	}
+
+	if v := d.Get("requested_access_token_version").(int); v > 0 {
+		result["accessTokenAcceptedVersion"] = v
+	} else if signInAudienceIsPersonal(signInAudience) {
+		// personal account audiences require v2 access tokens
+		result["accessTokenAcceptedVersion"] = 2
+	}
+
+	return result
+}
+
+func applicationDefaultIdentifierUri(appId string) string {
+	return "api://" + appId
+}
+
+// expandApplicationIdentifierUris returns the configured identifier URIs, along with the default identifier URI
+// when enabled. Since `identifier_uris` is computed, it may contain the default identifier URI from a previous read,
+// in which case it is removed when the default identifier URI is disabled.
+func expandApplicationIdentifierUris(d *schema.ResourceData, appId string) *[]string {
+	identifierUris := *tf.ExpandStringSlicePtr(d.Get("identifier_uris").([]interface{}))
+	if appId == "" {
+		return &identifierUris
+	}
+
+	defaultUri := applicationDefaultIdentifierUri(appId)
+
+	if !d.Get("default_identifier_uri").(bool) {
+		if d.HasChange("default_identifier_uri") {
+			result := make([]string, 0, len(identifierUris))
+			for _, uri := range identifierUris {
+				if uri != defaultUri {
+					result = append(result, uri)
+				}
+			}
+			return &result
+		}
+		return &identifierUris
+	}
+
+	for _, uri := range identifierUris {
+		if uri == defaultUri {
+			return &identifierUris
+		}
+	}
+	identifierUris = append(identifierUris, defaultUri)
+
+	return &identifierUris
+}
+
+// flattenApplicationDefaultIdentifierUri returns whether the default identifier URI is still present on the
+// application. This is never inferred from the application, since the default identifier URI could equally have
+// been specified in `identifier_uris`, so it is only detected as removed when previously enabled.
+func flattenApplicationDefaultIdentifierUri(d *schema.ResourceData, app graphrbac.Application) bool {
+	if !d.Get("default_identifier_uri").(bool) {
+		return false
+	}
+
+	if app.AppID == nil || app.IdentifierUris == nil {
+		return false
+	}
+
+	defaultUri := applicationDefaultIdentifierUri(*app.AppID)
+	for _, uri := range *app.IdentifierUris {
+		if uri == defaultUri {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenApplicationIdentifierUris returns the identifier URIs for an application. When `default_identifier_uri` is
+// enabled and other identifier URIs are being tracked, the default identifier URI is omitted unless it has also been
+// specified in `identifier_uris`, so that it does not conflict with the configured list.
+func flattenApplicationIdentifierUris(d *schema.ResourceData, app graphrbac.Application) []interface{} {
+	identifierUris := tf.FlattenStringSlicePtr(app.IdentifierUris)
+	if app.AppID == nil || !d.Get("default_identifier_uri").(bool) {
+		return identifierUris
+	}
+
+	existing := d.Get("identifier_uris").([]interface{})
+	if len(existing) == 0 {
+		return identifierUris
+	}
+
+	defaultUri := applicationDefaultIdentifierUri(*app.AppID)
+	for _, uri := range existing {
+		if uri == defaultUri {
+			return identifierUris
+		}
+	}
+
+	result := make([]interface{}, 0, len(identifierUris))
+	for _, uri := range identifierUris {
+		if uri != defaultUri {
+			result = append(result, uri)
+		}
+	}
+	return result
+}
+
+func applicationRedirectUrisConfigured(d *schema.ResourceData) bool {
+	for _, attr := range []string{"public_client_platform.0.redirect_uris", "spa.0.redirect_uris", "web.0.redirect_uris"} {
+		if v, ok := d.GetOk(attr); ok && v.(*schema.Set).Len() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func expandApplicationReplyUrlsWithType(d *schema.ResourceData) []interface{} {
+	result := make([]interface{}, 0)
+
+	for _, t := range []struct {
+		attr    string
+		urlType string
+	}{
+		{"web.0.redirect_uris", applicationReplyUrlTypeWeb},
+		{"spa.0.redirect_uris", applicationReplyUrlTypeSpa},
+		{"public_client_platform.0.redirect_uris", applicationReplyUrlTypeInstalledClient},
+	} {
+		v, ok := d.GetOk(t.attr)
+		if !ok {
+			continue
+		}
+		for _, uri := range v.(*schema.Set).List() {
+			result = append(result, map[string]interface{}{
+				"url":  uri.(string),
+				"type": t.urlType,
+			})
+		}
+	}
+
+	return result
+}
+
+// flattenApplicationRedirectUris sorts the reply URLs for an application by platform. Applications which have never had
+// typed reply URLs configured do not return the `replyUrlsWithType` property, in which case all reply URLs are
+// attributed to either the web or public client platform, depending on whether the application is a public client.
+func flattenApplicationRedirectUris(app graphrbac.Application) (web, spa, publicClient []string) {
+	web, spa, publicClient = make([]string, 0), make([]string, 0), make([]string, 0)
+
+	replyUrlsWithType, ok := app.AdditionalProperties["replyUrlsWithType"].([]interface{})
+	if !ok {
+		if app.ReplyUrls != nil {
+			if app.PublicClient != nil && *app.PublicClient {
+				publicClient = append(publicClient, *app.ReplyUrls...)
+			} else {
+				web = append(web, *app.ReplyUrls...)
+			}
+		}
+		return
+	}
+
+	for _, raw := range replyUrlsWithType {
+		replyUrl, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		url, ok := replyUrl["url"].(string)
+		if !ok {
+			continue
+		}
+		switch replyUrl["type"] {
+		case applicationReplyUrlTypeSpa:
+			spa = append(spa, url)
+		case applicationReplyUrlTypeInstalledClient:
+			publicClient = append(publicClient, url)
+		default:
+			web = append(web, url)
+		}
+	}
+
+	return
+}
+
+func flattenApplicationRedirectUrisBlock(redirectUris []string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"redirect_uris": redirectUris,
+		},
+	}
+}
+
+func flattenApplicationWeb(app graphrbac.Application, redirectUris []string) []interface{} {
+	homepageUrl := ""
+	if app.Homepage != nil {
+		homepageUrl = *app.Homepage
+	}
+
+	logoutUrl := ""
+	if app.LogoutURL != nil {
+		logoutUrl = *app.LogoutURL
+	}
+
+	accessTokenIssuanceEnabled := false
+	if app.Oauth2AllowImplicitFlow != nil {
+		accessTokenIssuanceEnabled = *app.Oauth2AllowImplicitFlow
+	}
+
+	idTokenIssuanceEnabled := false
+	if v, ok := app.AdditionalProperties["oauth2AllowIdTokenImplicitFlow"].(bool); ok {
+		idTokenIssuanceEnabled = v
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"homepage_url": homepageUrl,
+			"implicit_grant": []interface{}{
+				map[string]interface{}{
+					"access_token_issuance_enabled": accessTokenIssuanceEnabled,
+					"id_token_issuance_enabled":     idTokenIssuanceEnabled,
+				},
+			},
+			"logout_url":    logoutUrl,
+			"redirect_uris": redirectUris,
+		},
+	}
+}
+
+func flattenApplicationAccessTokenVersion(app graphrbac.Application) int {
+	// a null value indicates the default, which is v1
+	if v, ok := app.AdditionalProperties["accessTokenAcceptedVersion"].(float64); ok {
+		return int(v)
+	}
+	return 1
+}
+
+func expandApplicationRequiredResourceAccessAad(d *schema.ResourceData) *[]graphrbac.RequiredResourceAccess {
+	requiredResourcesAccesses := d.Get("required_resource_access").(*schema.Set).List()
+	result := make([]graphrbac.RequiredResourceAccess, 0)
+
+	for _, raw := range requiredResourcesAccesses {
+		requiredResourceAccess := raw.(map[string]interface{})
+		resource_app_id := requiredResourceAccess["resource_app_id"].(string)
+
+		result = append(result,
+			graphrbac.RequiredResourceAccess{
+				ResourceAppID: &resource_app_id,
+				ResourceAccess: expandApplicationResourceAccessAad(
+					requiredResourceAccess["resource_access"].([]interface{}),
+				),
+			},
+		)
+	}
+	return &result
+}
+
+func expandApplicationResourceAccessAad(in []interface{}) *[]graphrbac.ResourceAccess {
+	resourceAccesses := make([]graphrbac.ResourceAccess, 0, len(in))
+	for _, resourceAccessRaw := range in {
+		resourceAccess := resourceAccessRaw.(map[string]interface{})
+
+		resourceId := resourceAccess["id"].(string)
+		resourceType := resourceAccess["type"].(string)
+
+		resourceAccesses = append(resourceAccesses,
+			graphrbac.ResourceAccess{
+				ID:   &resourceId,
+				Type: &resourceType,
+			},
+		)
+	}
+
+	return &resourceAccesses
+}
+
+func flattenApplicationRequiredResourceAccessAad(in *[]graphrbac.RequiredResourceAccess) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := make([]map[string]interface{}, 0, len(*in))
+	for _, requiredResourceAccess := range *in {
+		resource := make(map[string]interface{})
+		if requiredResourceAccess.ResourceAppID != nil {
+			resource["resource_app_id"] = *requiredResourceAccess.ResourceAppID
+		}
+
+		resource["resource_access"] = flattenApplicationResourceAccessAad(requiredResourceAccess.ResourceAccess)
+
+		result = append(result, resource)
+	}
+
+	return result
+}
+
+func flattenApplicationResourceAccessAad(in *[]graphrbac.ResourceAccess) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	accesses := make([]interface{}, 0)
+	for _, resourceAccess := range *in {
+		access := make(map[string]interface{})
+		if resourceAccess.ID != nil {
+			access["id"] = *resourceAccess.ID
+		}
+		if resourceAccess.Type != nil {
+			access["type"] = *resourceAccess.Type
+		}
+		accesses = append(accesses, access)
+	}
+
+	return accesses
+}
+
+func expandApplicationOptionalClaimsAad(d *schema.ResourceData) *graphrbac.OptionalClaims {
+	result := graphrbac.OptionalClaims{}
+
+	for _, raw := range d.Get("optional_claims").([]interface{}) {
+		optionalClaims := raw.(map[string]interface{})
+		result.AccessToken = expandApplicationOptionalClaimAad(optionalClaims["access_token"].([]interface{}))
+		result.IDToken = expandApplicationOptionalClaimAad(optionalClaims["id_token"].([]interface{}))
+		// TODO: enable when https://github.com/Azure/azure-sdk-for-go/issues/9714 resolved
+		//result.SamlToken = expandApplicationOptionalClaim(optionalClaims["saml2_token"].([]interface{}))
+	}
+	return &result
+}
+
+func expandApplicationOptionalClaimAad(in []interface{}) *[]graphrbac.OptionalClaim {
+	optionalClaims := make([]graphrbac.OptionalClaim, 0, len(in))
+	for _, optionalClaimRaw := range in {
+		optionalClaim := optionalClaimRaw.(map[string]interface{})
+
+		name := optionalClaim["name"].(string)
+		essential := optionalClaim["essential"].(bool)
+		additionalProps := make([]string, 0)
+
+		if props := optionalClaim["additional_properties"]; props != nil {
+			for _, prop := range props.([]interface{}) {
+				additionalProps = append(additionalProps, prop.(string))
+			}
+		}
+
+		newClaim := graphrbac.OptionalClaim{
+			Name:                 &name,
+			Essential:            &essential,
+			AdditionalProperties: &additionalProps,
+		}
+
+		if source := optionalClaim["source"].(string); source != "" {
+			newClaim.Source = &source
+		}
+
+		optionalClaims = append(optionalClaims, newClaim)
+	}
+
+	return &optionalClaims
+}
+
+func flattenApplicationOptionalClaimsAad(in *graphrbac.OptionalClaims) interface{} {
+	var result []map[string]interface{}
+
+	if in == nil {
+		return result
+	}
+
+	optionalClaims := make(map[string]interface{})
+	if claims := flattenApplicationOptionalClaimsListAad(in.AccessToken); len(claims) > 0 {
+		optionalClaims["access_token"] = claims
+	}
+	if claims := flattenApplicationOptionalClaimsListAad(in.IDToken); len(claims) > 0 {
+		optionalClaims["id_token"] = claims
+	}
+	// TODO: enable when https://github.com/Azure/azure-sdk-for-go/issues/9714 resolved
+	//if claims := flattenApplicationOptionalClaimsList(in.SamlToken); len(claims) > 0 {
+	//	optionalClaims["saml_token"] = claims
+	//}
+	if len(optionalClaims) == 0 {
+		return result
+	}
+	result = append(result, optionalClaims)
+	return result
+}
+
+func flattenApplicationOptionalClaimsListAad(in *[]graphrbac.OptionalClaim) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	optionalClaims := make([]interface{}, 0)
+	for _, claim := range *in {
+		optionalClaim := make(map[string]interface{})
+		if claim.Name != nil {
+			optionalClaim["name"] = *claim.Name
+		}
+		if claim.Source != nil {
+			optionalClaim["source"] = *claim.Source
+		}
+		if claim.Essential != nil {
+			optionalClaim["essential"] = *claim.Essential
+		}
+		additionalProperties := make([]string, 0)
+		if props := claim.AdditionalProperties; props != nil {
+			for _, prop := range props.([]interface{}) {
+				additionalProperties = append(additionalProperties, prop.(string))
+			}
+		}
+		optionalClaim["additional_properties"] = additionalProperties
+		optionalClaims = append(optionalClaims, optionalClaim)
+	}
+
+	return optionalClaims
+}
+
+func expandApplicationAppRolesAad(i interface{}) *[]graphrbac.AppRole {
+	input := i.(*schema.Set).List()
+	output := make([]graphrbac.AppRole, 0, len(input))
+
+	for _, appRoleRaw := range input {
+		appRole := appRoleRaw.(map[string]interface{})
+
+		appRoleID := appRole["id"].(string)
+		if appRoleID == "" {
+			appRoleID, _ = uuid.GenerateUUID()
+		}
+
+		var appRoleAllowedMemberTypes []string
+		for _, appRoleAllowedMemberType := range appRole["allowed_member_types"].(*schema.Set).List() {
+			appRoleAllowedMemberTypes = append(appRoleAllowedMemberTypes, appRoleAllowedMemberType.(string))
+		}
+
+		appRoleDescription := appRole["description"].(string)
+		appRoleDisplayName := appRole["display_name"].(string)
+		appRoleIsEnabled := appRole["is_enabled"].(bool)
+
+		var appRoleValue *string
+		if v, ok := appRole["value"].(string); ok {
+			appRoleValue = &v
+		}
+
+		output = append(output,
+			graphrbac.AppRole{
+				ID:                 &appRoleID,
+				AllowedMemberTypes: &appRoleAllowedMemberTypes,
+				Description:        &appRoleDescription,
+				DisplayName:        &appRoleDisplayName,
+				IsEnabled:          &appRoleIsEnabled,
+				Value:              appRoleValue,
+			},
+		)
+	}
+
+	return &output
+}
+
+func expandApplicationOAuth2PermissionsAad(i interface{}) *[]graphrbac.OAuth2Permission {
+	input := i.(*schema.Set).List()
+	result := make([]graphrbac.OAuth2Permission, 0)
+
+	for _, raw := range input {
+		OAuth2Permissions := raw.(map[string]interface{})
+
+		AdminConsentDescription := OAuth2Permissions["admin_consent_description"].(string)
+		AdminConsentDisplayName := OAuth2Permissions["admin_consent_display_name"].(string)
+		ID := OAuth2Permissions["id"].(string)
+		if ID == "" {
+			ID, _ = uuid.GenerateUUID()
+		}
+
+		IsEnabled := OAuth2Permissions["is_enabled"].(bool)
+		Type := OAuth2Permissions["type"].(string)
+		UserConsentDescription := OAuth2Permissions["user_consent_description"].(string)
+		UserConsentDisplayName := OAuth2Permissions["user_consent_display_name"].(string)
+		Value := OAuth2Permissions["value"].(string)
+
+		result = append(result,
+			graphrbac.OAuth2Permission{
+				AdminConsentDescription: &AdminConsentDescription,
+				AdminConsentDisplayName: &AdminConsentDisplayName,
+				ID:                      &ID,
+				IsEnabled:               &IsEnabled,
+				Type:                    &Type,
+				UserConsentDescription:  &UserConsentDescription,
+				UserConsentDisplayName:  &UserConsentDisplayName,
+				Value:                   &Value,
+			},
+		)
+	}
+	return &result
+}
+
+func applicationValidateRolesScopes(appRoles, oauth2Permissions []interface{}) error {
+	var values []string
+
+	for _, roleRaw := range appRoles {
+		role := roleRaw.(map[string]interface{})
+		if val := role["value"].(string); val != "" {
+			values = append(values, val)
+		}
+	}
+
+	for _, scopeRaw := range oauth2Permissions {
+		scope := scopeRaw.(map[string]interface{})
+		if val := scope["value"].(string); val != "" {
+			values = append(values, val)
+		}
+	}
+
+	encountered := make([]string, len(values))
+	for _, val := range values {
+		for _, en := range encountered {
+			if en == val {
+				return fmt.Errorf("validation failed: duplicate value found: %q", val)
+			}
+		}
+		encountered = append(encountered, val)
+	}
+
+	return nil
+}
+
+func applicationResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "name"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "name"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"app_role": {
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"allowed_member_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice(
+									[]string{"User", "Application"},
+									false,
+								),
+							},
+						},
+
+						"description": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"display_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"is_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"available_to_other_tenants": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"group_membership_claims": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"All",
+					"None",
+					"SecurityGroup",
+					"DirectoryRole",    // missing from sdk: https://github.com/Azure/azure-sdk-for-go/issues/7857
+					"ApplicationGroup", //missing from sdk:https://github.com/Azure/azure-sdk-for-go/issues/8244
+				}, false),
+			},
+
+			"homepage": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.URLIsHTTPOrHTTPS,
+			},
+
+			"identifier_uris": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.URLIsAppURI,
+				},
+			},
+
+			"logout_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validate.URLIsHTTPOrHTTPS,
+			},
+
+			"oauth2_allow_implicit_flow": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"oauth2_permissions": {
+				Type:       schema.TypeSet,
+				Optional:   true,
+				Computed:   true,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"admin_consent_description": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"admin_consent_display_name": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"is_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Admin", "User"}, false),
+						},
+
+						"user_consent_description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+
+						"user_consent_display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+
+						"value": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"optional_claims": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_token": schemaOptionalClaims(),
+						"id_token":     schemaOptionalClaims(),
+					},
+				},
+			},
+
+			"owners": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"public_client": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"reply_urls": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"required_resource_access": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_app_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"resource_access": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validate.UUID,
+									},
+
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice(
+											[]string{"Scope", "Role"},
+											false, // force case sensitivity
+										),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"webapp/api", "native"}, false),
+				Default:      "webapp/api",
+			},
+
+			"application_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"prevent_duplicate_names": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func applicationResourceStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	log.Println("[DEBUG] Migrating reply URL, implicit flow and public client properties from v0 to v1 format")
+
+	publicClient, _ := rawState["public_client"].(bool)
+	rawState["fallback_public_client"] = publicClient
+
+	redirectUris := make([]interface{}, 0)
+	if v, ok := rawState["reply_urls"].([]interface{}); ok {
+		redirectUris = v
+	}
+
+	// prior to v1 all reply URLs were untyped, so they are attributed in the same way as when reading an application
+	// which has never had typed reply URLs configured
+	webRedirectUris, publicClientRedirectUris := redirectUris, make([]interface{}, 0)
+	if publicClient {
+		webRedirectUris, publicClientRedirectUris = publicClientRedirectUris, webRedirectUris
+	}
+
+	rawState["public_client_platform"] = []interface{}{
+		map[string]interface{}{
+			"redirect_uris": publicClientRedirectUris,
+		},
+	}
+
+	rawState["spa"] = []interface{}{
+		map[string]interface{}{
+			"redirect_uris": make([]interface{}, 0),
+		},
+	}
+
+	accessTokenIssuanceEnabled, _ := rawState["oauth2_allow_implicit_flow"].(bool)
+	rawState["web"] = []interface{}{
+		map[string]interface{}{
+			"homepage_url": rawState["homepage"],
+			"implicit_grant": []interface{}{
+				map[string]interface{}{
+					"access_token_issuance_enabled": accessTokenIssuanceEnabled,
+					"id_token_issuance_enabled":     false,
+				},
+			},
+			"logout_url":    rawState["logout_url"],
+			"redirect_uris": webRedirectUris,
+		},
+	}
+
+	return rawState, nil
+}
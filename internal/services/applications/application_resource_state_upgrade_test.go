@@ -0,0 +1,158 @@
+package applications
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestApplicationResourceStateUpgradeV0(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name: "web application",
+			Input: map[string]interface{}{
+				"homepage":                   "https://app.hashicorptest.net",
+				"logout_url":                 "https://app.hashicorptest.net/logout",
+				"oauth2_allow_implicit_flow": true,
+				"public_client":              false,
+				"reply_urls":                 []interface{}{"https://app.hashicorptest.net/callback"},
+			},
+			Expected: map[string]interface{}{
+				"fallback_public_client":     false,
+				"homepage":                   "https://app.hashicorptest.net",
+				"logout_url":                 "https://app.hashicorptest.net/logout",
+				"oauth2_allow_implicit_flow": true,
+				"public_client":              false,
+				"public_client_platform": []interface{}{
+					map[string]interface{}{
+						"redirect_uris": []interface{}{},
+					},
+				},
+				"reply_urls": []interface{}{"https://app.hashicorptest.net/callback"},
+				"spa": []interface{}{
+					map[string]interface{}{
+						"redirect_uris": []interface{}{},
+					},
+				},
+				"web": []interface{}{
+					map[string]interface{}{
+						"homepage_url": "https://app.hashicorptest.net",
+						"implicit_grant": []interface{}{
+							map[string]interface{}{
+								"access_token_issuance_enabled": true,
+								"id_token_issuance_enabled":     false,
+							},
+						},
+						"logout_url":    "https://app.hashicorptest.net/logout",
+						"redirect_uris": []interface{}{"https://app.hashicorptest.net/callback"},
+					},
+				},
+			},
+		},
+		{
+			Name: "public client",
+			Input: map[string]interface{}{
+				"homepage":                   nil,
+				"logout_url":                 nil,
+				"oauth2_allow_implicit_flow": false,
+				"public_client":              true,
+				"reply_urls":                 []interface{}{"myapp://auth", "urn:ietf:wg:oauth:2.0:oob"},
+			},
+			Expected: map[string]interface{}{
+				"fallback_public_client":     true,
+				"homepage":                   nil,
+				"logout_url":                 nil,
+				"oauth2_allow_implicit_flow": false,
+				"public_client":              true,
+				"public_client_platform": []interface{}{
+					map[string]interface{}{
+						"redirect_uris": []interface{}{"myapp://auth", "urn:ietf:wg:oauth:2.0:oob"},
+					},
+				},
+				"reply_urls": []interface{}{"myapp://auth", "urn:ietf:wg:oauth:2.0:oob"},
+				"spa": []interface{}{
+					map[string]interface{}{
+						"redirect_uris": []interface{}{},
+					},
+				},
+				"web": []interface{}{
+					map[string]interface{}{
+						"homepage_url": nil,
+						"implicit_grant": []interface{}{
+							map[string]interface{}{
+								"access_token_issuance_enabled": false,
+								"id_token_issuance_enabled":     false,
+							},
+						},
+						"logout_url":    nil,
+						"redirect_uris": []interface{}{},
+					},
+				},
+			},
+		},
+		{
+			Name: "no reply URLs",
+			Input: map[string]interface{}{
+				"homepage":                   nil,
+				"logout_url":                 nil,
+				"oauth2_allow_implicit_flow": nil,
+				"public_client":              nil,
+				"reply_urls":                 nil,
+			},
+			Expected: map[string]interface{}{
+				"fallback_public_client":     false,
+				"homepage":                   nil,
+				"logout_url":                 nil,
+				"oauth2_allow_implicit_flow": nil,
+				"public_client":              nil,
+				"public_client_platform": []interface{}{
+					map[string]interface{}{
+						"redirect_uris": []interface{}{},
+					},
+				},
+				"reply_urls": nil,
+				"spa": []interface{}{
+					map[string]interface{}{
+						"redirect_uris": []interface{}{},
+					},
+				},
+				"web": []interface{}{
+					map[string]interface{}{
+						"homepage_url": nil,
+						"implicit_grant": []interface{}{
+							map[string]interface{}{
+								"access_token_issuance_enabled": false,
+								"id_token_issuance_enabled":     false,
+							},
+						},
+						"logout_url":    nil,
+						"redirect_uris": []interface{}{},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, err := applicationResourceStateUpgradeV0(context.Background(), tc.Input, nil)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("Expected %+v, got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestApplicationResourceV0_internalValidate(t *testing.T) {
+	if err := applicationResourceV0().InternalValidate(nil, true); err != nil {
+		t.Fatalf("Expected the v0 schema to be valid, got: %v", err)
+	}
+}
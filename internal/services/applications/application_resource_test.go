@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
 )
 
@@ -72,6 +73,22 @@ func TestAccApplication_complete(t *testing.T) {
 	})
 }
 
+func TestAccApplication_importByNaturalKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepUsingAttribute("application_id"),
+		data.ImportStepUsingAttribute("identifier_uris.0"),
+	})
+}
+
 func TestAccApplication_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
@@ -316,6 +333,357 @@ func TestAccApplication_nativeAppDoesNotAllowIdentifierUris(t *testing.T) {
 	})
 }
 
+func TestAccApplication_signInAudience(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.signInAudience(data, "AzureADMultipleOrgs", 1),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("available_to_other_tenants").HasValue("true"),
+				check.That(data.ResourceName).Key("requested_access_token_version").HasValue("1"),
+				check.That(data.ResourceName).Key("sign_in_audience").HasValue("AzureADMultipleOrgs"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.signInAudience(data, "AzureADandPersonalMicrosoftAccount", 2),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("requested_access_token_version").HasValue("2"),
+				check.That(data.ResourceName).Key("sign_in_audience").HasValue("AzureADandPersonalMicrosoftAccount"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_availableToOtherTenantsUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.availableToOtherTenants(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("available_to_other_tenants").HasValue("false"),
+				check.That(data.ResourceName).Key("sign_in_audience").HasValue("AzureADMyOrg"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.availableToOtherTenants(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("available_to_other_tenants").HasValue("true"),
+				check.That(data.ResourceName).Key("sign_in_audience").HasValue("AzureADMultipleOrgs"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_signInAudiencePersonalDefaultTokenVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.signInAudienceNoTokenVersion(data, "AzureADMultipleOrgs"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("requested_access_token_version").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.signInAudienceNoTokenVersion(data, "AzureADandPersonalMicrosoftAccount"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("requested_access_token_version").HasValue("2"),
+				check.That(data.ResourceName).Key("sign_in_audience").HasValue("AzureADandPersonalMicrosoftAccount"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_signInAudiencePersonalRequiresV2Tokens(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.signInAudience(data, "PersonalMicrosoftAccount", 1),
+			ExpectError: regexp.MustCompile("`requested_access_token_version` must be 2"),
+		},
+	})
+}
+
+func TestAccApplication_redirectUris(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.redirectUris(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_client_platform.0.redirect_uris.#").HasValue("2"),
+				check.That(data.ResourceName).Key("spa.0.redirect_uris.#").HasValue("1"),
+				check.That(data.ResourceName).Key("web.0.homepage_url").HasValue(fmt.Sprintf("https://homepage-%d.hashicorptest.net", data.RandomInteger)),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.access_token_issuance_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.id_token_issuance_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("web.0.logout_url").HasValue("https://hashicorptest.net/logout"),
+				check.That(data.ResourceName).Key("web.0.redirect_uris.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the deprecated properties mirrored from the `web` block must not result in a diff
+			Config: r.redirectUris(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.access_token_issuance_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("web.0.logout_url").HasValue("https://hashicorptest.net/logout"),
+			),
+		},
+		{
+			Config:   r.redirectUris(data),
+			PlanOnly: true,
+		},
+		{
+			Config: r.redirectUrisUpdate(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_client_platform.0.redirect_uris.#").HasValue("0"),
+				check.That(data.ResourceName).Key("spa.0.redirect_uris.#").HasValue("2"),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.access_token_issuance_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("web.0.implicit_grant.0.id_token_issuance_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("web.0.redirect_uris.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_redirectUrisFromReplyUrls(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("web.0.redirect_uris.#").HasValue("1"),
+				check.That(data.ResourceName).Key("web.0.homepage_url").HasValue(fmt.Sprintf("https://homepage-%d", data.RandomInteger)),
+			),
+		},
+		{
+			Config: r.redirectUris(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("spa.0.redirect_uris.#").HasValue("1"),
+				check.That(data.ResourceName).Key("web.0.redirect_uris.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_replyUrlsOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.replyUrlsOnly(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("reply_urls.#").HasValue("2"),
+				check.That(data.ResourceName).Key("web.0.redirect_uris.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_replyUrlsInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.replyUrlsInvalid(data),
+			ExpectError: regexp.MustCompile("invalid value in `reply_urls`"),
+		},
+	})
+}
+
+func TestAccApplication_knownClientApplications(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.knownClientApplications(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("known_client_applications.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("known_client_applications.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_logoImage(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.logoImage(data, "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg=="),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("logo_image").HasValue("497790947d4666760ce38f3c00e852c71fdb66cae849bae8e9ede352719e1581"),
+			),
+		},
+		data.ImportStep("logo_image"),
+		{
+			Config: r.logoImage(data, "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mP8z8BQDwAEhQGAhKmMIQAAAABJRU5ErkJggg=="),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("logo_image").HasValue("c414cd0e204de974f73753c7e28d7638e7b3691bb8b1a2bab6b25bb7fed7ce77"),
+			),
+		},
+		data.ImportStep("logo_image"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("logo_image").IsEmpty(),
+				r.logoRemoved(data.ResourceName),
+			),
+		},
+	})
+}
+
+func TestAccApplication_defaultIdentifierUriImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	importStep := data.ImportStep("default_identifier_uri")
+	importStep.ImportStateCheck = r.importedWithoutDefaultIdentifierUri
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.defaultIdentifierUri(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.hasDefaultIdentifierUri(data.ResourceName, "identifier_uris.0"),
+			),
+		},
+		importStep,
+	})
+}
+
+func TestAccApplication_defaultIdentifierUri(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.defaultIdentifierUri(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_identifier_uri").HasValue("true"),
+				check.That(data.ResourceName).Key("identifier_uris.#").HasValue("1"),
+				r.hasDefaultIdentifierUri(data.ResourceName, "identifier_uris.0"),
+			),
+		},
+		data.ImportStep("default_identifier_uri"),
+		{
+			Config: r.defaultIdentifierUriWithOthers(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_identifier_uri").HasValue("true"),
+				check.That(data.ResourceName).Key("identifier_uris.#").HasValue("1"),
+				check.That(data.ResourceName).Key("identifier_uris.0").HasValue(fmt.Sprintf("api://acctest-APP-%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("default_identifier_uri", "identifier_uris"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_identifier_uri").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_fromTemplate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.fromTemplate(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("application_id").IsUuid(),
+				check.That("azuread_service_principal.test").Key("object_id").IsUuid(),
+				check.That("azuread_service_principal.test").Key("app_role_assignment_required").HasValue("true"),
+			),
+		},
+		data.ImportStep("template_id"),
+	})
+}
+
+func TestAccApplication_additionalProperties(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.additionalProperties(data, true),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("additional_properties").HasValue(`{"oauth2RequirePostResponse":true}`),
+			),
+		},
+		data.ImportStep("additional_properties"),
+		{
+			Config: r.additionalProperties(data, false),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("additional_properties").HasValue(`{"oauth2RequirePostResponse":false}`),
+			),
+		},
+		data.ImportStep("additional_properties"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApplication_oauth2PermissionsUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
@@ -338,13 +706,31 @@ func TestAccApplication_oauth2PermissionsUpdate(t *testing.T) {
 		},
 		data.ImportStep(),
 		{
-			Config: r.oauth2PermissionsEmpty(data),
+			Config: r.oauth2PermissionsEmpty(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("oauth2_permissions.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplication_roleAndScopeOwnershipDeclaredOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.roleAndScopeOwnershipDeclaredOnly(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_role.#").HasValue("1"),
 				check.That(data.ResourceName).Key("oauth2_permissions.#").HasValue("0"),
+				check.That(data.ResourceName).Key("role_and_scope_ownership").HasValue("DeclaredOnly"),
 			),
 		},
-		data.ImportStep(),
+		data.ImportStep("app_role", "oauth2_permissions", "role_and_scope_ownership"),
 	})
 }
 
@@ -408,6 +794,30 @@ func TestAccApplication_duplicateAppRolesOauth2PermissionsValues(t *testing.T) {
 	})
 }
 
+func TestAccApplication_identifierUriUnverifiedDomain(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.identifierUriUnverifiedDomain(data),
+			ExpectError: regexp.MustCompile("must use a verified domain for this tenant"),
+		},
+	})
+}
+
+func TestAccApplication_invalidRedirectUri(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.invalidRedirectUri(data),
+			ExpectError: regexp.MustCompile("Redirect URI must not contain a wildcard"),
+		},
+	})
+}
+
 func TestAccApplication_ownersUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
@@ -456,6 +866,62 @@ func TestAccApplication_ownersUpdate(t *testing.T) {
 	})
 }
 
+func TestAccApplication_ownersPreventSelfRemoval(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("owners.#").HasValue("1"),
+			),
+		},
+		{
+			Config:      r.singleOwner(data),
+			ExpectError: regexp.MustCompile("would remove the authenticated principal"),
+		},
+	})
+}
+
+func TestAccApplication_adoptExisting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.adoptExisting(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				resource.TestCheckResourceAttrPair("azuread_application.adopted", "object_id", data.ResourceName, "object_id"),
+			),
+		},
+	})
+}
+
+func TestAccApplication_hardDelete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTestWithCheckDestroy(t, r.checkPermanentlyDeleted, []resource.TestStep{
+		{
+			Config: r.hardDelete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("destroy_behavior").HasValue("HardDelete"),
+			),
+		},
+		data.ImportStep("destroy_behavior"),
+	})
+}
+
 func (r ApplicationResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	resp, err := clients.Applications.AadClient.Get(ctx, state.ID)
 
@@ -470,6 +936,98 @@ func (r ApplicationResource) Exists(ctx context.Context, clients *clients.Client
 	return utils.Bool(id != nil && *id == state.ID), nil
 }
 
+// checkPermanentlyDeleted checks that destroyed applications were deleted and are not in the deleted items
+func (ApplicationResource) checkPermanentlyDeleted(s *terraform.State) error {
+	client := acceptance.AzureADProvider.Meta().(*clients.Client)
+	ctx := client.StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_application" {
+			continue
+		}
+
+		resp, err := client.Applications.AadClient.Get(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Application with object ID %q still exists", rs.Primary.ID)
+		}
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("failed to retrieve Application with object ID %q: %+v", rs.Primary.ID, err)
+		}
+
+		item, err := client.Applications.DeletedItemsClient.Get(ctx, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Application with object ID %q was not permanently deleted", rs.Primary.ID)
+		}
+		if !utils.ResponseWasNotFound(item.Response) {
+			return fmt.Errorf("failed to retrieve deleted Application with object ID %q: %+v", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (ApplicationResource) logoRemoved(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureADProvider.Meta().(*clients.Client)
+		ctx := client.StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		logo, resp, err := aadgraph.ApplicationLogoGet(ctx, client.Applications.AadClient, rs.Primary.ID)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return nil
+			}
+			return fmt.Errorf("failed to retrieve logo for Application with object ID %q: %+v", rs.Primary.ID, err)
+		}
+		if len(logo) > 0 {
+			return fmt.Errorf("logo for Application with object ID %q was not removed", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func (ApplicationResource) hasDefaultIdentifierUri(resourceName, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		expected := fmt.Sprintf("api://%s", rs.Primary.Attributes["application_id"])
+		if v := rs.Primary.Attributes[key]; v != expected {
+			return fmt.Errorf("expected %q to be %q, got %q", key, expected, v)
+		}
+
+		return nil
+	}
+}
+
+// importedWithoutDefaultIdentifierUri checks that an imported application exports the default identifier URI in
+// `identifier_uris`, without inferring `default_identifier_uri`, so that configurations which specify the URI
+// explicitly do not remove it on the next apply
+func (ApplicationResource) importedWithoutDefaultIdentifierUri(states []*terraform.InstanceState) error {
+	if len(states) != 1 {
+		return fmt.Errorf("expected 1 imported state, got %d", len(states))
+	}
+	attrs := states[0].Attributes
+
+	if v := attrs["default_identifier_uri"]; v != "" && v != "false" {
+		return fmt.Errorf("expected `default_identifier_uri` to be false after import, got %q", v)
+	}
+
+	expected := fmt.Sprintf("api://%s", attrs["application_id"])
+	if v := attrs["identifier_uris.0"]; v != expected {
+		return fmt.Errorf("expected `identifier_uris.0` to be %q after import, got %q", expected, v)
+	}
+
+	return nil
+}
+
 func (ApplicationResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
@@ -478,6 +1036,15 @@ resource "azuread_application" "test" {
 `, data.RandomInteger)
 }
 
+func (ApplicationResource) availableToOtherTenants(data acceptance.TestData, available bool) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name               = "acctest-APP-%[1]d"
+  available_to_other_tenants = %[2]t
+}
+`, data.RandomInteger, available)
+}
+
 func (ApplicationResource) basicDeprecated(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
@@ -503,8 +1070,8 @@ func (ApplicationResource) publicClient(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
   display_name  = "acctest-APP-%[1]d"
-  type          = "native"
-  public_client = true
+  type                   = "native"
+  fallback_public_client = true
 }
 `, data.RandomInteger)
 }
@@ -749,6 +1316,27 @@ resource "azuread_application" "test" {
 `, data.RandomInteger)
 }
 
+func (ApplicationResource) replyUrlsOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+  reply_urls = [
+    "https://replyurl-%[1]d.hashicorptest.net/callback",
+    "http://localhost:8080/callback",
+  ]
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) replyUrlsInvalid(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+  reply_urls   = ["http://replyurl-%[1]d.hashicorptest.net/callback"]
+}
+`, data.RandomInteger)
+}
+
 func (ApplicationResource) nativeAppDoesNotAllowIdentifierUris(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
@@ -759,6 +1347,184 @@ resource "azuread_application" "test" {
 `, data.RandomInteger)
 }
 
+func (ApplicationResource) signInAudience(data acceptance.TestData, audience string, version int) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name                   = "acctest-APP-%[1]d"
+  identifier_uris                = ["api://hashicorptestapp-%[1]d"]
+  requested_access_token_version = %[3]d
+  sign_in_audience               = "%[2]s"
+}
+`, data.RandomInteger, audience, version)
+}
+
+func (ApplicationResource) signInAudienceNoTokenVersion(data acceptance.TestData, audience string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name     = "acctest-APP-%[1]d"
+  identifier_uris  = ["api://hashicorptestapp-%[1]d"]
+  sign_in_audience = "%[2]s"
+}
+`, data.RandomInteger, audience)
+}
+
+func (ApplicationResource) redirectUris(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  public_client_platform {
+    redirect_uris = [
+      "myapp://auth",
+      "urn:ietf:wg:oauth:2.0:oob",
+    ]
+  }
+
+  spa {
+    redirect_uris = ["https://spa-%[1]d.hashicorptest.net/"]
+  }
+
+  web {
+    homepage_url = "https://homepage-%[1]d.hashicorptest.net"
+    logout_url   = "https://hashicorptest.net/logout"
+
+    redirect_uris = [
+      "https://web-%[1]d.hashicorptest.net/callback",
+      "https://web-%[1]d.hashicorptest.net/signin",
+    ]
+
+    implicit_grant {
+      access_token_issuance_enabled = true
+      id_token_issuance_enabled     = true
+    }
+  }
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) redirectUrisUpdate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  public_client_platform {
+    redirect_uris = []
+  }
+
+  spa {
+    redirect_uris = [
+      "https://spa-%[1]d.hashicorptest.net/",
+      "https://spa-%[1]d.hashicorptest.net/signin",
+    ]
+  }
+
+  web {
+    homepage_url  = "https://homepage-%[1]d.hashicorptest.net"
+    logout_url    = "https://hashicorptest.net/logout"
+    redirect_uris = ["https://web-%[1]d.hashicorptest.net/callback"]
+
+    implicit_grant {
+      access_token_issuance_enabled = false
+      id_token_issuance_enabled     = false
+    }
+  }
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) knownClientApplications(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "client" {
+  display_name = "acctest-APP-client-%[1]d"
+}
+
+resource "azuread_application" "test" {
+  display_name              = "acctest-APP-%[1]d"
+  known_client_applications = [azuread_application.client.application_id]
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) logoImage(data acceptance.TestData, image string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+  logo_image   = "%[2]s"
+}
+`, data.RandomInteger, image)
+}
+
+func (ApplicationResource) roleAndScopeOwnershipDeclaredOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name             = "acctest-APP-%[1]d"
+  role_and_scope_ownership = "DeclaredOnly"
+
+  app_role {
+    allowed_member_types = ["User"]
+    description          = "Admins can manage roles and perform all task actions"
+    display_name         = "Admin"
+    is_enabled           = true
+    value                = "inline"
+  }
+}
+
+resource "azuread_application_app_role" "test" {
+  application_object_id = azuread_application.test.id
+  allowed_member_types  = ["Application"]
+  description           = "Applications can read data"
+  display_name          = "Reader"
+  is_enabled            = true
+  value                 = "standalone"
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) defaultIdentifierUri(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name           = "acctest-APP-%[1]d"
+  default_identifier_uri = true
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) defaultIdentifierUriWithOthers(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name           = "acctest-APP-%[1]d"
+  default_identifier_uri = true
+  identifier_uris        = ["api://acctest-APP-%[1]d"]
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) additionalProperties(data acceptance.TestData, value bool) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctest-APP-%[1]d"
+
+  additional_properties = jsonencode({
+    oauth2RequirePostResponse = %[2]t
+  })
+}
+`, data.RandomInteger, value)
+}
+
+func (ApplicationResource) fromTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+  template_id  = "%[2]s"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id               = azuread_application.test.application_id
+  app_role_assignment_required = true
+}
+`, data.RandomInteger, applicationTemplateNonGalleryId)
+}
+
 func (ApplicationResource) preventDuplicateNamesPass(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
@@ -880,6 +1646,10 @@ resource "azuread_application" "test" {
 
 func (r ApplicationResource) removeOwners(data acceptance.TestData) string {
 	return fmt.Sprintf(`
+provider "azuread" {
+  allow_owner_self_removal = true
+}
+
 %[1]s
 
 resource "azuread_application" "test" {
@@ -888,3 +1658,45 @@ resource "azuread_application" "test" {
 }
 `, r.templateThreeUsers(data), data.RandomInteger)
 }
+
+func (r ApplicationResource) adoptExisting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "adopted" {
+  display_name   = azuread_application.test.display_name
+  adopt_existing = true
+}
+`, r.basic(data))
+}
+
+func (ApplicationResource) hardDelete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name     = "acctest-APP-%[1]d"
+  destroy_behavior = "HardDelete"
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) identifierUriUnverifiedDomain(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name     = "acctest-APP-%[1]d"
+  sign_in_audience = "AzureADMultipleOrgs"
+  identifier_uris  = ["https://acctest-%[1]d.example.com/app"]
+}
+`, data.RandomInteger)
+}
+
+func (ApplicationResource) invalidRedirectUri(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  spa {
+    redirect_uris = ["https://*.hashicorptest.net/"]
+  }
+}
+`, data.RandomInteger)
+}
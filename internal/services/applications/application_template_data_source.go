@@ -0,0 +1,147 @@
+package applications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func applicationTemplateDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: applicationTemplateDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"template_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "template_id"},
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"display_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ExactlyOneOf:     []string{"display_name", "template_id"},
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"categories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"homepage_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"logo_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"publisher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"supported_provisioning_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"supported_single_sign_on_modes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func applicationTemplateDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationTemplatesClient
+
+	var template *msgraph.ApplicationTemplate
+
+	if v, ok := d.GetOk("template_id"); ok {
+		templateId := v.(string)
+		result, err := client.Get(ctx, templateId)
+		if err != nil {
+			if utils.ResponseWasNotFound(result.Response) {
+				return tf.ErrorDiagPathF(nil, "template_id", "Application template with ID %q was not found", templateId)
+			}
+			return tf.ErrorDiagPathF(err, "template_id", "Retrieving application template with ID %q", templateId)
+		}
+		template = &result
+	} else {
+		displayName := d.Get("display_name").(string)
+		filter := fmt.Sprintf("displayName eq '%s'", strings.ReplaceAll(displayName, "'", "''"))
+
+		result, err := client.List(ctx, filter)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Listing application templates for filter %q", filter)
+		}
+
+		var matches []msgraph.ApplicationTemplate
+		if result.Value != nil {
+			for _, t := range *result.Value {
+				if t.DisplayName != nil && strings.EqualFold(*t.DisplayName, displayName) {
+					matches = append(matches, t)
+				}
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return tf.ErrorDiagPathF(nil, "display_name", "No application template found matching display name: %q", displayName)
+		case 1:
+			template = &matches[0]
+		default:
+			return tf.ErrorDiagPathF(nil, "display_name", "Found %d application templates matching display name %q, please specify `template_id` instead", len(matches), displayName)
+		}
+	}
+
+	if template.ID == nil {
+		return tf.ErrorDiagF(errors.New("ID returned for application template is nil"), "Bad API response")
+	}
+
+	d.SetId(*template.ID)
+
+	tf.Set(d, "categories", tf.FlattenStringSlicePtr(template.Categories))
+	tf.Set(d, "description", template.Description)
+	tf.Set(d, "display_name", template.DisplayName)
+	tf.Set(d, "homepage_url", template.HomePageURL)
+	tf.Set(d, "logo_url", template.LogoURL)
+	tf.Set(d, "publisher", template.Publisher)
+	tf.Set(d, "supported_provisioning_types", tf.FlattenStringSlicePtr(template.SupportedProvisioningTypes))
+	tf.Set(d, "supported_single_sign_on_modes", tf.FlattenStringSlicePtr(template.SupportedSingleSignOnModes))
+	tf.Set(d, "template_id", template.ID)
+
+	return nil
+}
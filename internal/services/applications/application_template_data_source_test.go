@@ -0,0 +1,66 @@
+package applications_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+)
+
+// the template for custom applications which are not in the gallery
+const applicationTemplateNonGalleryId = "8adf8e6e-67b2-4cf2-a259-e3dc5476c621"
+
+type ApplicationTemplateDataSource struct{}
+
+func TestAccApplicationTemplateDataSource_byTemplateId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_application_template", "test")
+	r := ApplicationTemplateDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.byTemplateId(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("template_id").HasValue(applicationTemplateNonGalleryId),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("supported_single_sign_on_modes.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccApplicationTemplateDataSource_byDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_application_template", "test")
+	r := ApplicationTemplateDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.byDisplayName(),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("template_id").HasValue(applicationTemplateNonGalleryId),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+			),
+		},
+	})
+}
+
+func (ApplicationTemplateDataSource) byTemplateId() string {
+	return `
+data "azuread_application_template" "test" {
+  template_id = "` + applicationTemplateNonGalleryId + `"
+}
+`
+}
+
+func (r ApplicationTemplateDataSource) byDisplayName() string {
+	return `
+data "azuread_application_template" "lookup" {
+  template_id = "` + applicationTemplateNonGalleryId + `"
+}
+
+data "azuread_application_template" "test" {
+  display_name = data.azuread_application_template.lookup.display_name
+}
+`
+}
@@ -2,18 +2,41 @@ package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+
 	"github.com/terraform-providers/terraform-provider-azuread/internal/common"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
 )
 
 type Client struct {
 	AadClient *graphrbac.ApplicationsClient
+
+	ApplicationTemplatesClient         *msgraph.ApplicationTemplatesClient
+	DeletedItemsClient                 *msgraph.DeletedItemsClient
+	FederatedIdentityCredentialsClient *msgraph.FederatedIdentityCredentialsClient
+	PoliciesClient                     *msgraph.PoliciesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	aadClient := graphrbac.NewApplicationsClientWithBaseURI(o.AadGraphEndpoint, o.TenantID)
 	o.ConfigureClient(&aadClient.Client, o.AadGraphAuthorizer)
 
+	applicationTemplatesClient := msgraph.NewApplicationTemplatesClientWithBaseURI(o.MsGraphEndpoint)
+	o.ConfigureClient(&applicationTemplatesClient.Client, o.MsGraphAuthorizer)
+
+	deletedItemsClient := msgraph.NewDeletedItemsClientWithBaseURI(o.MsGraphEndpoint)
+	o.ConfigureClient(&deletedItemsClient.Client, o.MsGraphAuthorizer)
+
+	federatedIdentityCredentialsClient := msgraph.NewFederatedIdentityCredentialsClientWithBaseURI(o.MsGraphEndpoint)
+	o.ConfigureClient(&federatedIdentityCredentialsClient.Client, o.MsGraphAuthorizer)
+
+	policiesClient := msgraph.NewPoliciesClientWithBaseURI(o.MsGraphEndpoint)
+	o.ConfigureClient(&policiesClient.Client, o.MsGraphAuthorizer)
+
 	return &Client{
-		AadClient: &aadClient,
+		AadClient:                          &aadClient,
+		ApplicationTemplatesClient:         &applicationTemplatesClient,
+		DeletedItemsClient:                 &deletedItemsClient,
+		FederatedIdentityCredentialsClient: &federatedIdentityCredentialsClient,
+		PoliciesClient:                     &policiesClient,
 	}
 }
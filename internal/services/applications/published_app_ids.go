@@ -0,0 +1,40 @@
+package applications
+
+// publishedAppIdsVersion should be bumped whenever the publishedAppIds table is changed, so that practitioners can
+// tell which revision of the table a given provider release ships with
+const publishedAppIdsVersion = "2021-02-01"
+
+// publishedAppIds is a table of well-known application IDs for Microsoft first-party applications, keyed by a friendly
+// name. These application IDs are the same in all tenants, so they are embedded here rather than looked up.
+var publishedAppIds = map[string]string{
+	"ApplicationInsightsApi":          "f5c26e74-f226-4ae8-85f0-b4af0080ac9e",
+	"AzureActiveDirectoryGraph":       "00000002-0000-0000-c000-000000000000",
+	"AzureAppService":                 "abfa0a7c-a6b6-4736-8310-5855508787cd",
+	"AzureBatch":                      "ddbf3205-c6bd-46ae-8127-60eb93363864",
+	"AzureCli":                        "04b07795-8ddb-461a-bbee-02f9e1bf7b46",
+	"AzureDataExplorer":               "2746ea77-4702-4b45-80ca-3c97e680e8b7",
+	"AzureDataLake":                   "e9f49c6b-5ce5-44c8-925d-015017e9f7ad",
+	"AzureDevOps":                     "499b84ac-1321-427f-aa17-267ca6975798",
+	"AzureKeyVault":                   "cfa8b339-82a2-471a-a3c9-0fc0be7a4093",
+	"AzureKubernetesServiceAadServer": "6dae42f8-4368-4678-94ff-3960e28e3630",
+	"AzurePortal":                     "c44b4083-3bb0-49c1-b47d-974e53cbdf3c",
+	"AzurePowerShell":                 "1950a258-227b-4e31-a9cf-717495945fc2",
+	"AzureServiceManagement":          "797f4846-ba00-4fd7-ba43-dac1f8f63013",
+	"AzureSqlDatabase":                "022907d3-0f1b-48f7-badc-1ba6abab6d66",
+	"AzureStorage":                    "e406a681-f3d4-42a8-90b6-c2b029497af1",
+	"AzureVirtualDesktop":             "9cdead84-a844-4324-93f2-b2e6bb768d07",
+	"DynamicsCrm":                     "00000007-0000-0000-c000-000000000000",
+	"LogAnalyticsApi":                 "ca7f3f0b-7d91-482c-8e09-c5d840d0eac5",
+	"MicrosoftGraph":                  "00000003-0000-0000-c000-000000000000",
+	"MicrosoftGraphPowerShell":        "14d82eec-204b-4c2f-b7e8-296a70dab67e",
+	"MicrosoftOffice":                 "d3590ed6-52b3-4102-aeff-aad2292ab01c",
+	"MicrosoftTeams":                  "1fec8e78-bce4-4aaf-ab1b-5451cc387264",
+	"MicrosoftTeamsService":           "cc15fd57-2c6c-4117-a88c-83b1d56b4bbe",
+	"Office365ExchangeOnline":         "00000002-0000-0ff1-ce00-000000000000",
+	"Office365Management":             "c5393580-f805-4401-95e8-94b7a6ef2fc2",
+	"Office365SharePointOnline":       "00000003-0000-0ff1-ce00-000000000000",
+	"PowerBiService":                  "00000009-0000-0000-c000-000000000000",
+	"SkypeForBusinessOnline":          "00000004-0000-0ff1-ce00-000000000000",
+	"VisualStudio":                    "872cd9fa-d31f-45e0-9eab-6e460a02d1f1",
+	"Yammer":                          "00000005-0000-0ff1-ce00-000000000000",
+}
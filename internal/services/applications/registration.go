@@ -21,17 +21,21 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azuread_application": applicationDataSource(),
+		"azuread_application":                   applicationDataSource(),
+		"azuread_application_published_app_ids": applicationPublishedAppIdsDataSource(),
+		"azuread_application_template":          applicationTemplateDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azuread_application":                   applicationResource(),
-		"azuread_application_app_role":          applicationAppRoleResource(),
-		"azuread_application_certificate":       applicationCertificateResource(),
-		"azuread_application_oauth2_permission": applicationOAuth2PermissionResource(),
-		"azuread_application_password":          applicationPasswordResource(),
+		"azuread_application":                               applicationResource(),
+		"azuread_application_app_role":                      applicationAppRoleResource(),
+		"azuread_application_certificate":                   applicationCertificateResource(),
+		"azuread_application_federated_identity_credential": applicationFederatedIdentityCredentialResource(),
+		"azuread_application_oauth2_permission":             applicationOAuth2PermissionResource(),
+		"azuread_application_password":                      applicationPasswordResource(),
+		"azuread_application_pre_authorized":                applicationPreAuthorizedResource(),
 	}
 }
@@ -3,6 +3,8 @@ package applications
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
 )
 
 func schemaOptionalClaims() *schema.Schema {
@@ -52,3 +54,15 @@ func schemaOptionalClaims() *schema.Schema {
 		},
 	}
 }
+
+func schemaRedirectUris(validateFunc schema.SchemaValidateDiagFunc) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: validate.RedirectUriMaxCount,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: validateFunc,
+		},
+	}
+}
@@ -1,12 +1,19 @@
 package client
 
 import (
+	"context"
+	"fmt"
+	"sync"
+
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/common"
 )
 
 type Client struct {
 	AadClient *graphrbac.DomainsClient
+
+	tenantDomains     *[]graphrbac.Domain
+	tenantDomainsLock sync.Mutex
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -17,3 +24,27 @@ func NewClient(o *common.ClientOptions) *Client {
 		AadClient: &aadClient,
 	}
 }
+
+// TenantDomains returns all domains for the tenant. These are retrieved once and then cached for the lifetime of the
+// provider, so that plan-time validation across many resources does not repeatedly list the domains.
+func (c *Client) TenantDomains(ctx context.Context) ([]graphrbac.Domain, error) {
+	c.tenantDomainsLock.Lock()
+	defer c.tenantDomainsLock.Unlock()
+
+	if c.tenantDomains != nil {
+		return *c.tenantDomains, nil
+	}
+
+	result, err := c.AadClient.List(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("listing domains: %+v", err)
+	}
+
+	domains := make([]graphrbac.Domain, 0)
+	if result.Value != nil {
+		domains = *result.Value
+	}
+	c.tenantDomains = &domains
+
+	return domains, nil
+}
@@ -32,6 +32,11 @@ func groupDataSource() *schema.Resource {
 				ValidateDiagFunc: validate.UUID,
 			},
 
+			"object_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -128,5 +133,11 @@ func groupDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 	tf.Set(d, "owners", owners)
 
+	objectJson, err := aadgraph.GroupObjectJSON(ctx, client, *group.ObjectID)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "object_json", "Retrieving JSON representation of group with object ID %q", *group.ObjectID)
+	}
+	tf.Set(d, "object_json", objectJson)
+
 	return nil
 }
@@ -20,6 +20,7 @@ func TestAccGroupDataSource_byName(t *testing.T) {
 			Config: GroupDataSource{}.name(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("object_json").Exists(),
 			),
 		},
 	})
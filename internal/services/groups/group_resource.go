@@ -5,11 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
@@ -18,6 +20,12 @@ import (
 	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
 )
 
+// behaviours when the resource is destroyed
+const (
+	groupDestroyBehaviorDelete  = "Delete"
+	groupDestroyBehaviorAbandon = "Abandon"
+)
+
 func groupResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: groupResourceCreate,
@@ -25,12 +33,9 @@ func groupResource() *schema.Resource {
 		UpdateContext: groupResourceUpdate,
 		DeleteContext: groupResourceDelete,
 
-		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
-			if _, err := uuid.ParseUUID(id); err != nil {
-				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
-			}
-			return nil
-		}),
+		CustomizeDiff: groupResourceCustomizeDiff,
+
+		Importer: tf.ResolveResourceIDPriorToImport(groupResourceImportResolve),
 
 		Schema: map[string]*schema.Schema{
 			"display_name": {
@@ -52,12 +57,24 @@ func groupResource() *schema.Resource {
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
+			"additional_properties": aadgraph.AdditionalPropertiesSchema(),
+
 			"description": {
 				Type:     schema.TypeString,
 				ForceNew: true, // there is no update method available in the SDK
 				Optional: true,
 			},
 
+			"destroy_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  groupDestroyBehaviorDelete,
+				ValidateFunc: validation.StringInSlice([]string{
+					groupDestroyBehaviorDelete,
+					groupDestroyBehaviorAbandon,
+				}, false),
+			},
+
 			"members": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -84,15 +101,41 @@ func groupResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"adopt_existing": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"prevent_duplicate_names"},
+			},
+
 			"prevent_duplicate_names": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"adopt_existing"},
 			},
 		},
 	}
 }
 
+// groupResourceCustomizeDiff prevents changes to `owners` which would remove the authenticated principal, or all owners,
+// from an existing group unless this has been explicitly allowed in the provider configuration
+func groupResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	c := meta.(*clients.Client)
+	if c.AllowOwnerSelfRemoval || diff.Id() == "" || !diff.HasChange("owners") || !diff.NewValueKnown("owners") {
+		return nil
+	}
+
+	oldOwners, newOwners := diff.GetChange("owners")
+	existing := *tf.ExpandStringSlicePtr(oldOwners.(*schema.Set).List())
+	desired := *tf.ExpandStringSlicePtr(newOwners.(*schema.Set).List())
+	if err := aadgraph.OwnersCheckSelfLockout(c.ObjectID, existing, desired); err != nil {
+		return fmt.Errorf("cannot update `owners`: %+v. To allow this, set `allow_owner_self_removal` in the provider configuration", err)
+	}
+
+	return nil
+}
+
 func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.AadClient
 
@@ -116,6 +159,21 @@ func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	if d.Get("adopt_existing").(bool) {
+		objectIds, err := groupFindIdsByDisplayName(ctx, client, name)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "display_name", "Could not check for existing group(s)")
+		}
+		switch len(objectIds) {
+		case 0:
+			log.Printf("[DEBUG] No existing group found with display name %q - creating a new group", name)
+		case 1:
+			return groupResourceAdopt(ctx, d, meta, objectIds[0])
+		default:
+			return tf.ErrorDiagPathF(fmt.Errorf("found %d groups with display name %q: %s", len(objectIds), name, strings.Join(objectIds, ", ")), "display_name", "Could not adopt existing group")
+		}
+	}
+
 	mailNickname, err := uuid.GenerateUUID()
 	if err != nil {
 		return tf.ErrorDiagF(err, "Failed to generate mailNickname")
@@ -133,6 +191,14 @@ func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		properties.AdditionalProperties["description"] = v.(string)
 	}
 
+	if v, ok := d.GetOk("additional_properties"); ok {
+		additionalProperties, err := aadgraph.ExpandAdditionalProperties(v.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Parsing additional properties")
+		}
+		aadgraph.MergeAdditionalProperties(properties.AdditionalProperties, additionalProperties)
+	}
+
 	group, err := client.Create(ctx, properties)
 	if err != nil {
 		return tf.ErrorDiagF(err, "Creating group %q", name)
@@ -174,6 +240,59 @@ func groupResourceCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		if err := aadgraph.GroupAddOwners(ctx, client, *group.ObjectID, ownersToAdd); err != nil {
 			return tf.ErrorDiagF(err, "Adding group owners")
 		}
+	} else if c := meta.(*clients.Client); c.AddCallerAsOwner && c.ObjectID != "" {
+		existingOwners, err := aadgraph.GroupAllOwners(ctx, client, *group.ObjectID)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve group owners")
+		}
+
+		if err := aadgraph.GroupAddOwners(ctx, client, *group.ObjectID, utils.Difference([]string{c.ObjectID}, existingOwners)); err != nil {
+			return tf.ErrorDiagF(err, "Adding the authenticated principal as a group owner")
+		}
+	}
+
+	return groupResourceRead(ctx, d, meta)
+}
+
+// groupResourceAdopt takes over an existing group with the configured display name and reconciles its description,
+// additional properties, members and owners with the configuration
+func groupResourceAdopt(ctx context.Context, d *schema.ResourceData, meta interface{}, objectId string) diag.Diagnostics {
+	client := meta.(*clients.Client).Groups.AadClient
+
+	log.Printf("[DEBUG] Adopting existing group with object ID %q", objectId)
+
+	properties := map[string]interface{}{
+		"description": nil,
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		properties["description"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		additionalProperties, err := aadgraph.ExpandAdditionalProperties(v.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Parsing additional properties")
+		}
+		aadgraph.MergeAdditionalProperties(properties, additionalProperties)
+	}
+
+	if _, err := aadgraph.GroupPatchProperties(ctx, client, objectId, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating existing group with object ID %q", objectId)
+	}
+
+	d.SetId(objectId)
+
+	if v, ok := d.GetOk("members"); ok {
+		if diags := groupResourceSetMembers(ctx, d, meta, *tf.ExpandStringSlicePtr(v.(*schema.Set).List())); diags.HasError() {
+			return diags
+		}
+	}
+
+	if v, ok := d.GetOk("owners"); ok {
+		if diags := groupResourceSetOwners(ctx, d, meta, *tf.ExpandStringSlicePtr(v.(*schema.Set).List())); diags.HasError() {
+			return diags
+		}
 	}
 
 	return groupResourceRead(ctx, d, meta)
@@ -193,6 +312,18 @@ func groupResourceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return tf.ErrorDiagF(err, "Retrieving group with object ID: %q", d.Id())
 	}
 
+	additionalProperties := ""
+	if v := d.Get("additional_properties").(string); v != "" {
+		properties, err := aadgraph.GroupObjectProperties(ctx, client, d.Id())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Retrieving additional properties for group with object ID %q", d.Id())
+		}
+		if additionalProperties, err = aadgraph.FlattenAdditionalProperties(properties, v); err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Flattening additional properties for group with object ID %q", d.Id())
+		}
+	}
+
+	tf.Set(d, "additional_properties", additionalProperties)
 	tf.Set(d, "object_id", resp.ObjectID)
 	tf.Set(d, "display_name", resp.DisplayName)
 	tf.Set(d, "name", resp.DisplayName)
@@ -220,6 +351,13 @@ func groupResourceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		preventDuplicates = v
 	}
 	tf.Set(d, "prevent_duplicate_names", preventDuplicates)
+	tf.Set(d, "adopt_existing", d.Get("adopt_existing").(bool))
+
+	destroyBehavior := d.Get("destroy_behavior").(string)
+	if destroyBehavior == "" {
+		destroyBehavior = groupDestroyBehaviorDelete
+	}
+	tf.Set(d, "destroy_behavior", destroyBehavior)
 
 	return nil
 }
@@ -227,55 +365,39 @@ func groupResourceRead(ctx context.Context, d *schema.ResourceData, meta interfa
 func groupResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.AadClient
 
-	if v, ok := d.GetOkExists("members"); ok && d.HasChange("members") { //nolint:SA1019
-		existingMembers, err := aadgraph.GroupAllMembers(ctx, client, d.Id())
+	if d.HasChange("additional_properties") {
+		oldProps, newProps := d.GetChange("additional_properties")
+		additionalProperties, err := aadgraph.ExpandAdditionalPropertiesChange(oldProps.(string), newProps.(string))
 		if err != nil {
-			return tf.ErrorDiagPathF(err, "owners", "Could not retrieve members for group with object ID %q", d.Id())
+			return tf.ErrorDiagPathF(err, "additional_properties", "Parsing additional properties")
 		}
-
-		desiredMembers := *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
-		membersForRemoval := utils.Difference(existingMembers, desiredMembers)
-		membersToAdd := utils.Difference(desiredMembers, existingMembers)
-
-		for _, existingMember := range membersForRemoval {
-			log.Printf("[DEBUG] Removing member with id %q from Group with id %q", existingMember, d.Id())
-			if err := aadgraph.GroupRemoveMember(ctx, client, d.Timeout(schema.TimeoutDelete), d.Id(), existingMember); err != nil {
-				return tf.ErrorDiagF(err, "Removing group members")
-			}
-
-			if _, err := aadgraph.WaitForListRemove(ctx, existingMember, func() ([]string, error) {
-				return aadgraph.GroupAllMembers(ctx, client, d.Id())
-			}); err != nil {
-				return tf.ErrorDiagF(err, "Waiting for group membership removal")
-			}
+		if _, err := aadgraph.GroupPatchProperties(ctx, client, d.Id(), additionalProperties); err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Could not set additional properties")
 		}
+	}
 
-		if err := aadgraph.GroupAddMembers(ctx, client, d.Id(), membersToAdd); err != nil {
-			return tf.ErrorDiagF(err, "Adding group members")
+	if v, ok := d.GetOkExists("members"); ok && d.HasChange("members") { //nolint:SA1019
+		if diags := groupResourceSetMembers(ctx, d, meta, *tf.ExpandStringSlicePtr(v.(*schema.Set).List())); diags.HasError() {
+			return diags
 		}
 	}
 
 	if v, ok := d.GetOkExists("owners"); ok && d.HasChange("owners") { //nolint:SA1019
-		existingOwners, err := aadgraph.GroupAllOwners(ctx, client, d.Id())
-		if err != nil {
-			return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for group with object ID %q", d.Id())
-		}
-
 		desiredOwners := *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
-		ownersForRemoval := utils.Difference(existingOwners, desiredOwners)
-		ownersToAdd := utils.Difference(desiredOwners, existingOwners)
-
-		for _, ownerToDelete := range ownersForRemoval {
-			log.Printf("[DEBUG] Removing member with ID %q from Group with ID %q", ownerToDelete, d.Id())
-			if resp, err := client.RemoveOwner(ctx, d.Id(), ownerToDelete); err != nil {
-				if !utils.ResponseWasNotFound(resp) {
-					return tf.ErrorDiagF(err, "Removing group owner %q from group with object ID: %q", ownerToDelete, d.Id())
-				}
+
+		// the desired owners may not have been known at plan time, so check again against the current owners
+		if c := meta.(*clients.Client); !c.AllowOwnerSelfRemoval {
+			existingOwners, err := aadgraph.GroupAllOwners(ctx, client, d.Id())
+			if err != nil {
+				return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for group with object ID %q", d.Id())
+			}
+			if err := aadgraph.OwnersCheckSelfLockout(c.ObjectID, existingOwners, desiredOwners); err != nil {
+				return tf.ErrorDiagPathF(err, "owners", "Cannot update owners for group with object ID %q. To allow this, set `allow_owner_self_removal` in the provider configuration", d.Id())
 			}
 		}
 
-		if err := aadgraph.GroupAddOwners(ctx, client, d.Id(), ownersToAdd); err != nil {
-			return tf.ErrorDiagF(err, "Adding group owners")
+		if diags := groupResourceSetOwners(ctx, d, meta, desiredOwners); diags.HasError() {
+			return diags
 		}
 	}
 
@@ -285,6 +407,13 @@ func groupResourceUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 func groupResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Groups.AadClient
 
+	if d.Get("destroy_behavior").(string) == groupDestroyBehaviorAbandon {
+		log.Printf("[INFO] Abandoning group with object ID %q - it will be removed from state but not deleted", d.Id())
+		return tf.DestroyBehaviorDiag("azuread_group", d.Id(), groupDestroyBehaviorAbandon, "removed from state but not deleted")
+	}
+
+	log.Printf("[INFO] Deleting group with object ID %q", d.Id())
+
 	if resp, err := client.Delete(ctx, d.Id()); err != nil {
 		if !utils.ResponseWasNotFound(resp) {
 			return tf.ErrorDiagF(err, "Deleting group with object ID: %q", d.Id())
@@ -293,3 +422,110 @@ func groupResourceDelete(ctx context.Context, d *schema.ResourceData, meta inter
 
 	return nil
 }
+
+// groupResourceSetMembers adds and removes members of a group so that they match the desired members
+func groupResourceSetMembers(ctx context.Context, d *schema.ResourceData, meta interface{}, desiredMembers []string) diag.Diagnostics {
+	client := meta.(*clients.Client).Groups.AadClient
+
+	existingMembers, err := aadgraph.GroupAllMembers(ctx, client, d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "owners", "Could not retrieve members for group with object ID %q", d.Id())
+	}
+
+	membersForRemoval := utils.Difference(existingMembers, desiredMembers)
+	membersToAdd := utils.Difference(desiredMembers, existingMembers)
+
+	for _, existingMember := range membersForRemoval {
+		log.Printf("[DEBUG] Removing member with id %q from Group with id %q", existingMember, d.Id())
+		if err := aadgraph.GroupRemoveMember(ctx, client, d.Timeout(schema.TimeoutDelete), d.Id(), existingMember); err != nil {
+			return tf.ErrorDiagF(err, "Removing group members")
+		}
+
+		if _, err := aadgraph.WaitForListRemove(ctx, existingMember, func() ([]string, error) {
+			return aadgraph.GroupAllMembers(ctx, client, d.Id())
+		}); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for group membership removal")
+		}
+	}
+
+	if err := aadgraph.GroupAddMembers(ctx, client, d.Id(), membersToAdd); err != nil {
+		return tf.ErrorDiagF(err, "Adding group members")
+	}
+
+	return nil
+}
+
+// groupResourceSetOwners adds and removes owners of a group so that they match the desired owners
+func groupResourceSetOwners(ctx context.Context, d *schema.ResourceData, meta interface{}, desiredOwners []string) diag.Diagnostics {
+	client := meta.(*clients.Client).Groups.AadClient
+
+	existingOwners, err := aadgraph.GroupAllOwners(ctx, client, d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "owners", "Could not retrieve owners for group with object ID %q", d.Id())
+	}
+
+	ownersForRemoval := utils.Difference(existingOwners, desiredOwners)
+	ownersToAdd := utils.Difference(desiredOwners, existingOwners)
+
+	for _, ownerToDelete := range ownersForRemoval {
+		log.Printf("[DEBUG] Removing member with ID %q from Group with ID %q", ownerToDelete, d.Id())
+		if resp, err := client.RemoveOwner(ctx, d.Id(), ownerToDelete); err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return tf.ErrorDiagF(err, "Removing group owner %q from group with object ID: %q", ownerToDelete, d.Id())
+			}
+		}
+	}
+
+	if err := aadgraph.GroupAddOwners(ctx, client, d.Id(), ownersToAdd); err != nil {
+		return tf.ErrorDiagF(err, "Adding group owners")
+	}
+
+	return nil
+}
+
+// groupResourceImportResolve resolves the ID specified for an import, which can be either the object ID or the display
+// name of the group, to the object ID. An error is returned when more than one group has the specified display name.
+func groupResourceImportResolve(ctx context.Context, id string, meta interface{}) (string, error) {
+	if _, err := uuid.ParseUUID(id); err == nil {
+		return id, nil
+	}
+
+	client := meta.(*clients.Client).Groups.AadClient
+
+	objectIds, err := groupFindIdsByDisplayName(ctx, client, id)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(objectIds) {
+	case 0:
+		return "", fmt.Errorf("no group found with display name %q", id)
+	case 1:
+		return objectIds[0], nil
+	}
+
+	return "", fmt.Errorf("found %d groups with display name %q, import one of these using its object ID instead: %s", len(objectIds), id, strings.Join(objectIds, ", "))
+}
+
+// groupFindIdsByDisplayName returns the object IDs of all groups with the specified display name
+func groupFindIdsByDisplayName(ctx context.Context, client *graphrbac.GroupsClient, displayName string) ([]string, error) {
+	filter := fmt.Sprintf("displayName eq '%s'", aadgraph.ODataString(displayName))
+	result, err := client.ListComplete(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing groups for filter %q: %+v", filter, err)
+	}
+
+	objectIds := make([]string, 0)
+	for result.NotDone() {
+		group := result.Value()
+		if group.ObjectID != nil && group.DisplayName != nil && strings.EqualFold(*group.DisplayName, displayName) {
+			objectIds = append(objectIds, *group.ObjectID)
+		}
+
+		if err := result.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing groups for filter %q: %+v", filter, err)
+		}
+	}
+
+	return objectIds, nil
+}
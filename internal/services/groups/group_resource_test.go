@@ -3,6 +3,7 @@ package groups_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -33,6 +34,21 @@ func TestAccGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccGroup_importByDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepUsingAttribute("display_name"),
+	})
+}
+
 func TestAccGroup_basicDeprecated(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -219,6 +235,48 @@ func TestAccGroup_ownersUpdate(t *testing.T) {
 	})
 }
 
+func TestAccGroup_ownersPreventRemovingAll(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.withOneOwner(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.withNoOwners(data),
+			ExpectError: regexp.MustCompile("would remove all owners"),
+		},
+	})
+}
+
+func TestAccGroup_additionalProperties(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.additionalProperties(data, "first"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("additional_properties").HasValue(fmt.Sprintf(`{"mailNickname":"acctestGroup-first-%d"}`, data.RandomInteger)),
+			),
+		},
+		data.ImportStep("additional_properties"),
+		{
+			Config: r.additionalProperties(data, "second"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("additional_properties").HasValue(fmt.Sprintf(`{"mailNickname":"acctestGroup-second-%d"}`, data.RandomInteger)),
+			),
+		},
+		data.ImportStep("additional_properties"),
+	})
+}
+
 func TestAccGroup_preventDuplicateNamesPass(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -243,6 +301,43 @@ func TestAccGroup_preventDuplicateNamesFail(t *testing.T) {
 	})
 }
 
+func TestAccGroup_adoptExisting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.adoptExisting(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				resource.TestCheckResourceAttrPair("azuread_group.adopted", "object_id", data.ResourceName, "object_id"),
+			),
+		},
+	})
+}
+
+func TestAccGroup_destroyBehaviorAbandon(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTestWithCheckDestroy(t, r.checkAbandoned, []resource.TestStep{
+		{
+			Config: r.destroyBehaviorAbandon(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("destroy_behavior").HasValue("Abandon"),
+			),
+		},
+		data.ImportStep("destroy_behavior"),
+	})
+}
+
 func (r GroupResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	resp, err := clients.Groups.AadClient.Get(ctx, state.ID)
 
@@ -257,6 +352,28 @@ func (r GroupResource) Exists(ctx context.Context, clients *clients.Client, stat
 	return utils.Bool(id != nil && *id == state.ID), nil
 }
 
+// checkAbandoned checks that destroyed groups were left in place, and then deletes them
+func (GroupResource) checkAbandoned(s *terraform.State) error {
+	client := acceptance.AzureADProvider.Meta().(*clients.Client)
+	ctx := client.StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_group" {
+			continue
+		}
+
+		if _, err := client.Groups.AadClient.Get(ctx, rs.Primary.ID); err != nil {
+			return fmt.Errorf("expected Group with object ID %q to still exist after being destroyed: %+v", rs.Primary.ID, err)
+		}
+
+		if _, err := client.Groups.AadClient.Delete(ctx, rs.Primary.ID); err != nil {
+			return fmt.Errorf("deleting abandoned Group with object ID %q: %+v", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
 func (GroupResource) templateDiverseDirectoryObjects(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 data "azuread_domains" "test" {
@@ -318,6 +435,27 @@ resource "azuread_group" "test" {
 `, data.RandomInteger)
 }
 
+func (GroupResource) destroyBehaviorAbandon(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  destroy_behavior = "Abandon"
+}
+`, data.RandomInteger)
+}
+
+func (GroupResource) additionalProperties(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name = "acctestGroup-%[1]d"
+
+  additional_properties = jsonencode({
+    mailNickname = "acctestGroup-%[2]s-%[1]d"
+  })
+}
+`, data.RandomInteger, value)
+}
+
 func (GroupResource) basicDeprecated(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_group" "test" {
@@ -400,6 +538,17 @@ resource "azuread_group" "test" {
 `, r.templateThreeUsers(data), data.RandomInteger)
 }
 
+func (r GroupResource) withNoOwners(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "test" {
+  display_name = "acctestGroup-%[2]d"
+  owners       = []
+}
+`, r.templateThreeUsers(data), data.RandomInteger)
+}
+
 func (r GroupResource) withThreeMembers(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -487,3 +636,14 @@ resource "azuread_group" "duplicate" {
 }
 `, r.basic(data))
 }
+
+func (r GroupResource) adoptExisting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "adopted" {
+  display_name   = azuread_group.test.display_name
+  adopt_existing = true
+}
+`, r.basic(data))
+}
@@ -0,0 +1,145 @@
+package policies
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+// activityBasedTimeoutPolicyDefaultApplication is the application ID used for the timeout which applies to all
+// applications which support activity-based timeouts
+const activityBasedTimeoutPolicyDefaultApplication = "default"
+
+type activityBasedTimeoutPolicyDefinition struct {
+	ActivityBasedTimeoutPolicy struct {
+		Version             int                                     `json:"Version"`
+		ApplicationPolicies []activityBasedTimeoutApplicationPolicy `json:"ApplicationPolicies"`
+	} `json:"ActivityBasedTimeoutPolicy"`
+}
+
+type activityBasedTimeoutApplicationPolicy struct {
+	ApplicationId         string `json:"ApplicationId"`
+	WebSessionIdleTimeout string `json:"WebSessionIdleTimeout"`
+}
+
+func activityBasedTimeoutPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: activityBasedTimeoutPolicyResourceCreate,
+		ReadContext:   activityBasedTimeoutPolicyResourceRead,
+		UpdateContext: activityBasedTimeoutPolicyResourceUpdate,
+		DeleteContext: activityBasedTimeoutPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: policyResourceImporter,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"web_session_idle_timeout": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateTimespan(time.Minute, 0),
+				DiffSuppressFunc: timespanDiffSuppress,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"is_organization_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func activityBasedTimeoutPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	definition, err := activityBasedTimeoutPolicyRenderFromResource(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Rendering activity-based timeout policy definition")
+	}
+
+	if diags := policyResourceCreate(ctx, d, meta, msgraph.PolicyTypeActivityBasedTimeout, "activity-based timeout policy", definition); diags.HasError() {
+		return diags
+	}
+
+	return activityBasedTimeoutPolicyResourceRead(ctx, d, meta)
+}
+
+func activityBasedTimeoutPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var definition *string
+	if d.HasChange("web_session_idle_timeout") {
+		rendered, err := activityBasedTimeoutPolicyRenderFromResource(d)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Rendering activity-based timeout policy definition")
+		}
+		definition = &rendered
+	}
+
+	if diags := policyResourceUpdate(ctx, d, meta, msgraph.PolicyTypeActivityBasedTimeout, "activity-based timeout policy", definition); diags.HasError() {
+		return diags
+	}
+
+	return activityBasedTimeoutPolicyResourceRead(ctx, d, meta)
+}
+
+func activityBasedTimeoutPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policy, diags := policyResourceRead(ctx, d, meta, msgraph.PolicyTypeActivityBasedTimeout, "activity-based timeout policy")
+	if policy == nil {
+		return diags
+	}
+
+	var definition activityBasedTimeoutPolicyDefinition
+	if err := policyDefinitionDecode(policy, &definition); err != nil {
+		return tf.ErrorDiagF(err, "Parsing definition for activity-based timeout policy with ID %q", d.Id())
+	}
+
+	webSessionIdleTimeout := ""
+	for _, p := range definition.ActivityBasedTimeoutPolicy.ApplicationPolicies {
+		if strings.EqualFold(p.ApplicationId, activityBasedTimeoutPolicyDefaultApplication) {
+			webSessionIdleTimeout = p.WebSessionIdleTimeout
+		}
+	}
+
+	tf.Set(d, "is_organization_default", policyIsOrganizationDefault(policy))
+	tf.Set(d, "web_session_idle_timeout", webSessionIdleTimeout)
+
+	return nil
+}
+
+func activityBasedTimeoutPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return policyResourceDelete(ctx, d, meta, msgraph.PolicyTypeActivityBasedTimeout, "activity-based timeout policy")
+}
+
+func activityBasedTimeoutPolicyRenderFromResource(d *schema.ResourceData) (string, error) {
+	var definition activityBasedTimeoutPolicyDefinition
+	definition.ActivityBasedTimeoutPolicy.Version = 1
+	definition.ActivityBasedTimeoutPolicy.ApplicationPolicies = []activityBasedTimeoutApplicationPolicy{
+		{
+			ApplicationId:         activityBasedTimeoutPolicyDefaultApplication,
+			WebSessionIdleTimeout: d.Get("web_session_idle_timeout").(string),
+		},
+	}
+
+	return policyDefinitionEncode(definition)
+}
@@ -0,0 +1,64 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+type ActivityBasedTimeoutPolicyResource struct{}
+
+func TestAccActivityBasedTimeoutPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_activity_based_timeout_policy", "test")
+	r := ActivityBasedTimeoutPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "01:00:00"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("is_organization_default").HasValue("true"),
+				check.That(data.ResourceName).Key("web_session_idle_timeout").HasValue("01:00:00"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "04:30:00"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("web_session_idle_timeout").HasValue("04:30:00"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ActivityBasedTimeoutPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	resp, err := clients.Policies.MsGraphClient.Get(ctx, msgraph.PolicyTypeActivityBasedTimeout, state.ID)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("Activity-Based Timeout Policy with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Activity-Based Timeout Policy with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(resp.ID != nil && *resp.ID == state.ID), nil
+}
+
+func (ActivityBasedTimeoutPolicyResource) basic(data acceptance.TestData, timeout string) string {
+	return fmt.Sprintf(`
+resource "azuread_activity_based_timeout_policy" "test" {
+  display_name             = "acctest-ActivityBasedTimeoutPolicy-%[1]d"
+  web_session_idle_timeout = "%[2]s"
+}
+`, data.RandomInteger, timeout)
+}
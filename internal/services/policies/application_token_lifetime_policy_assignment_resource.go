@@ -0,0 +1,20 @@
+package policies
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+)
+
+func applicationTokenLifetimePolicyAssignmentResource() *schema.Resource {
+	return policyAssignment{
+		resourceName: "azuread_application_token_lifetime_policy_assignment",
+		objectType:   msgraph.PolicyObjectTypeApplication,
+		objectField:  "application_object_id",
+		objectName:   "application",
+		policyType:   msgraph.PolicyTypeTokenLifetime,
+		policyField:  "token_lifetime_policy_id",
+		policyName:   "token lifetime policy",
+		idType:       "tokenLifetimePolicy",
+	}.resource()
+}
@@ -0,0 +1,86 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+)
+
+type ApplicationTokenLifetimePolicyAssignmentResource struct{}
+
+func TestAccApplicationTokenLifetimePolicyAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_token_lifetime_policy_assignment", "test")
+	r := ApplicationTokenLifetimePolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That("azuread_application.test").Key("token_lifetime_policy_ids.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccApplicationTokenLifetimePolicyAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_token_lifetime_policy_assignment", "test")
+	r := ApplicationTokenLifetimePolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r ApplicationTokenLifetimePolicyAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	return policyAssignmentExists(ctx, clients, state, msgraph.PolicyObjectTypeApplication, msgraph.PolicyTypeTokenLifetime, "tokenLifetimePolicy")
+}
+
+func (ApplicationTokenLifetimePolicyAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestApp-%[1]d"
+}
+
+resource "azuread_token_lifetime_policy" "test" {
+  display_name          = "acctest-TokenLifetimePolicy-%[1]d"
+  access_token_lifetime = "02:00:00"
+}
+
+resource "azuread_application_token_lifetime_policy_assignment" "test" {
+  application_object_id    = azuread_application.test.object_id
+  token_lifetime_policy_id = azuread_token_lifetime_policy.test.id
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationTokenLifetimePolicyAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_token_lifetime_policy_assignment" "import" {
+  application_object_id    = azuread_application_token_lifetime_policy_assignment.test.application_object_id
+  token_lifetime_policy_id = azuread_application_token_lifetime_policy_assignment.test.token_lifetime_policy_id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,281 @@
+package policies
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	claimsMappingPolicySourceApplication    = "application"
+	claimsMappingPolicySourceAudience       = "audience"
+	claimsMappingPolicySourceCompany        = "company"
+	claimsMappingPolicySourceResource       = "resource"
+	claimsMappingPolicySourceTransformation = "transformation"
+	claimsMappingPolicySourceUser           = "user"
+)
+
+// claimsMappingPolicyDefinition is the JSON definition of a claims mapping policy. Field names are matched
+// case-insensitively when decoding, so definitions created outside of Terraform are also understood.
+type claimsMappingPolicyDefinition struct {
+	ClaimsMappingPolicy claimsMappingPolicy `json:"ClaimsMappingPolicy"`
+}
+
+type claimsMappingPolicy struct {
+	Version               int                           `json:"Version"`
+	IncludeBasicClaimSet  policyBool                    `json:"IncludeBasicClaimSet"`
+	ClaimsSchema          []claimsMappingSchemaEntry    `json:"ClaimsSchema,omitempty"`
+	ClaimsTransformations []claimsMappingTransformation `json:"ClaimsTransformations,omitempty"`
+}
+
+type claimsMappingSchemaEntry struct {
+	Source           string `json:"Source,omitempty"`
+	ID               string `json:"ID,omitempty"`
+	ExtensionID      string `json:"ExtensionID,omitempty"`
+	Value            string `json:"Value,omitempty"`
+	JwtClaimType     string `json:"JwtClaimType,omitempty"`
+	SamlClaimType    string `json:"SamlClaimType,omitempty"`
+	SamlNameFormat   string `json:"SamlNameFormat,omitempty"`
+	TransformationID string `json:"TransformationId,omitempty"`
+}
+
+type claimsMappingTransformation struct {
+	ID                   string                        `json:"ID"`
+	TransformationMethod string                        `json:"TransformationMethod"`
+	InputClaims          []claimsMappingTransformClaim `json:"InputClaims,omitempty"`
+	InputParameters      []claimsMappingTransformParam `json:"InputParameters,omitempty"`
+	OutputClaims         []claimsMappingTransformClaim `json:"OutputClaims,omitempty"`
+}
+
+type claimsMappingTransformClaim struct {
+	ClaimTypeReferenceID    string `json:"ClaimTypeReferenceId"`
+	TransformationClaimType string `json:"TransformationClaimType"`
+}
+
+type claimsMappingTransformParam struct {
+	ID       string `json:"ID"`
+	Value    string `json:"Value"`
+	DataType string `json:"DataType,omitempty"`
+}
+
+// policyBool is a boolean which is encoded as a string in policy definitions, but which may have been written as a
+// JSON boolean by other tools
+type policyBool bool
+
+func (b policyBool) MarshalJSON() ([]byte, error) {
+	if b {
+		return []byte(`"true"`), nil
+	}
+	return []byte(`"false"`), nil
+}
+
+func (b *policyBool) UnmarshalJSON(data []byte) error {
+	switch strings.ToLower(strings.Trim(string(data), `"`)) {
+	case "true":
+		*b = true
+	case "false", "", "null":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean value %s", data)
+	}
+	return nil
+}
+
+func expandClaimsMappingPolicyDefinition(includeBasicClaimSet bool, claimSchema, claimsTransformations []interface{}) claimsMappingPolicyDefinition {
+	policy := claimsMappingPolicy{
+		Version:              1,
+		IncludeBasicClaimSet: policyBool(includeBasicClaimSet),
+	}
+
+	for _, raw := range claimSchema {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+		policy.ClaimsSchema = append(policy.ClaimsSchema, claimsMappingSchemaEntry{
+			Source:           v["source"].(string),
+			ID:               v["id"].(string),
+			ExtensionID:      v["extension_id"].(string),
+			Value:            v["value"].(string),
+			JwtClaimType:     v["jwt_claim_type"].(string),
+			SamlClaimType:    v["saml_claim_type"].(string),
+			SamlNameFormat:   v["saml_name_format"].(string),
+			TransformationID: v["transformation_id"].(string),
+		})
+	}
+
+	for _, raw := range claimsTransformations {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		transformation := claimsMappingTransformation{
+			ID:                   v["id"].(string),
+			TransformationMethod: v["transformation_method"].(string),
+			InputClaims:          expandClaimsMappingTransformClaims(v["input_claim"].([]interface{})),
+			OutputClaims:         expandClaimsMappingTransformClaims(v["output_claim"].([]interface{})),
+		}
+
+		for _, rawParam := range v["input_parameter"].([]interface{}) {
+			if rawParam == nil {
+				continue
+			}
+			param := rawParam.(map[string]interface{})
+			transformation.InputParameters = append(transformation.InputParameters, claimsMappingTransformParam{
+				ID:       param["id"].(string),
+				Value:    param["value"].(string),
+				DataType: param["data_type"].(string),
+			})
+		}
+
+		policy.ClaimsTransformations = append(policy.ClaimsTransformations, transformation)
+	}
+
+	return claimsMappingPolicyDefinition{ClaimsMappingPolicy: policy}
+}
+
+func expandClaimsMappingTransformClaims(in []interface{}) []claimsMappingTransformClaim {
+	result := make([]claimsMappingTransformClaim, 0)
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+		result = append(result, claimsMappingTransformClaim{
+			ClaimTypeReferenceID:    v["claim_type_reference_id"].(string),
+			TransformationClaimType: v["transformation_claim_type"].(string),
+		})
+	}
+	return result
+}
+
+func flattenClaimsMappingSchema(in []claimsMappingSchemaEntry) []interface{} {
+	result := make([]interface{}, 0, len(in))
+	for _, e := range in {
+		result = append(result, map[string]interface{}{
+			"extension_id":      e.ExtensionID,
+			"id":                e.ID,
+			"jwt_claim_type":    e.JwtClaimType,
+			"saml_claim_type":   e.SamlClaimType,
+			"saml_name_format":  e.SamlNameFormat,
+			"source":            strings.ToLower(e.Source),
+			"transformation_id": e.TransformationID,
+			"value":             e.Value,
+		})
+	}
+	return result
+}
+
+func flattenClaimsMappingTransformations(in []claimsMappingTransformation) []interface{} {
+	result := make([]interface{}, 0, len(in))
+	for _, t := range in {
+		params := make([]interface{}, 0, len(t.InputParameters))
+		for _, p := range t.InputParameters {
+			params = append(params, map[string]interface{}{
+				"data_type": p.DataType,
+				"id":        p.ID,
+				"value":     p.Value,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":                    t.ID,
+			"input_claim":           flattenClaimsMappingTransformClaims(t.InputClaims),
+			"input_parameter":       params,
+			"output_claim":          flattenClaimsMappingTransformClaims(t.OutputClaims),
+			"transformation_method": t.TransformationMethod,
+		})
+	}
+	return result
+}
+
+func flattenClaimsMappingTransformClaims(in []claimsMappingTransformClaim) []interface{} {
+	result := make([]interface{}, 0, len(in))
+	for _, c := range in {
+		result = append(result, map[string]interface{}{
+			"claim_type_reference_id":   c.ClaimTypeReferenceID,
+			"transformation_claim_type": c.TransformationClaimType,
+		})
+	}
+	return result
+}
+
+// renderClaimsMappingPolicyDefinition encodes the definition of a claims mapping policy. The result is canonical, so
+// that definitions which are semantically equal are rendered identically.
+func renderClaimsMappingPolicyDefinition(definition claimsMappingPolicyDefinition) (string, error) {
+	for i := range definition.ClaimsMappingPolicy.ClaimsSchema {
+		definition.ClaimsMappingPolicy.ClaimsSchema[i].Source = strings.ToLower(definition.ClaimsMappingPolicy.ClaimsSchema[i].Source)
+	}
+
+	return policyDefinitionEncode(definition)
+}
+
+// validateClaimsMappingPolicyDefinition checks that a claims mapping policy definition is consistent, so that errors
+// are reported at plan time rather than by the API
+func validateClaimsMappingPolicyDefinition(definition claimsMappingPolicyDefinition) error {
+	policy := definition.ClaimsMappingPolicy
+
+	schemaIds := make(map[string]bool)
+	for _, e := range policy.ClaimsSchema {
+		if e.ID != "" {
+			schemaIds[e.ID] = true
+		}
+	}
+
+	transformationIds := make(map[string]bool)
+	for _, t := range policy.ClaimsTransformations {
+		if transformationIds[t.ID] {
+			return fmt.Errorf("claims transformation ID %q is declared more than once", t.ID)
+		}
+		transformationIds[t.ID] = true
+
+		if len(t.OutputClaims) == 0 {
+			return fmt.Errorf("claims transformation %q must have at least one `output_claim`", t.ID)
+		}
+
+		for _, c := range append(append([]claimsMappingTransformClaim{}, t.InputClaims...), t.OutputClaims...) {
+			if !schemaIds[c.ClaimTypeReferenceID] {
+				return fmt.Errorf("claims transformation %q references claim %q, which is not declared by any `claim_schema` block", t.ID, c.ClaimTypeReferenceID)
+			}
+		}
+	}
+
+	for _, e := range policy.ClaimsSchema {
+		name := e.ID
+		if name == "" {
+			name = e.Value
+		}
+
+		if e.JwtClaimType == "" && e.SamlClaimType == "" {
+			return fmt.Errorf("claim schema entry %q must specify at least one of `jwt_claim_type` or `saml_claim_type`", name)
+		}
+
+		switch {
+		case e.Value != "":
+			if e.Source != "" || e.ID != "" || e.ExtensionID != "" || e.TransformationID != "" {
+				return fmt.Errorf("claim schema entry with value %q cannot also specify `source`, `id`, `extension_id` or `transformation_id`", e.Value)
+			}
+		case e.Source == "":
+			return fmt.Errorf("claim schema entry %q must specify either `source` or `value`", name)
+		case strings.EqualFold(e.Source, claimsMappingPolicySourceTransformation):
+			if e.TransformationID == "" {
+				return fmt.Errorf("claim schema entry %q has source `transformation` and must specify `transformation_id`", name)
+			}
+			if !transformationIds[e.TransformationID] {
+				return fmt.Errorf("claim schema entry %q references transformation %q, which is not declared by any `claims_transformation` block", name, e.TransformationID)
+			}
+			if e.ID == "" {
+				return fmt.Errorf("claim schema entry with transformation %q must specify `id`", e.TransformationID)
+			}
+		default:
+			if e.TransformationID != "" {
+				return fmt.Errorf("claim schema entry %q can only specify `transformation_id` when `source` is `transformation`", name)
+			}
+			if (e.ID == "") == (e.ExtensionID == "") {
+				return fmt.Errorf("claim schema entry with source %q must specify exactly one of `id` or `extension_id`", e.Source)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,298 @@
+package policies
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func claimsMappingPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: claimsMappingPolicyResourceCreate,
+		ReadContext:   claimsMappingPolicyResourceRead,
+		UpdateContext: claimsMappingPolicyResourceUpdate,
+		DeleteContext: claimsMappingPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: policyResourceImporter,
+
+		CustomizeDiff: claimsMappingPolicyResourceCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"claim_schema": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"extension_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"jwt_claim_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"saml_claim_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"saml_name_format": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"source": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								claimsMappingPolicySourceApplication,
+								claimsMappingPolicySourceAudience,
+								claimsMappingPolicySourceCompany,
+								claimsMappingPolicySourceResource,
+								claimsMappingPolicySourceTransformation,
+								claimsMappingPolicySourceUser,
+							}, false),
+						},
+
+						"transformation_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"value": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
+			"claims_transformation": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"transformation_method": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+
+						"input_claim": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     claimsMappingTransformClaimSchema(),
+						},
+
+						"input_parameter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_type": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: validate.NoEmptyStrings,
+									},
+
+									"id": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validate.NoEmptyStrings,
+									},
+
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+
+						"output_claim": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     claimsMappingTransformClaimSchema(),
+						},
+					},
+				},
+			},
+
+			"definition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"include_basic_claim_set": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func claimsMappingTransformClaimSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"claim_type_reference_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"transformation_claim_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func claimsMappingPolicyResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("claim_schema") || !diff.NewValueKnown("claims_transformation") || !diff.NewValueKnown("include_basic_claim_set") {
+		if diff.HasChange("claim_schema") || diff.HasChange("claims_transformation") || diff.HasChange("include_basic_claim_set") {
+			return diff.SetNewComputed("definition")
+		}
+		return nil
+	}
+
+	definition := expandClaimsMappingPolicyDefinition(
+		diff.Get("include_basic_claim_set").(bool),
+		diff.Get("claim_schema").(*schema.Set).List(),
+		diff.Get("claims_transformation").(*schema.Set).List())
+
+	if err := validateClaimsMappingPolicyDefinition(definition); err != nil {
+		return err
+	}
+
+	rendered, err := renderClaimsMappingPolicyDefinition(definition)
+	if err != nil {
+		return err
+	}
+
+	if rendered != diff.Get("definition").(string) {
+		return diff.SetNew("definition", rendered)
+	}
+
+	return nil
+}
+
+func claimsMappingPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rendered, err := claimsMappingPolicyRenderFromResource(d)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "claim_schema", "Rendering claims mapping policy definition")
+	}
+
+	if diags := policyResourceCreate(ctx, d, meta, msgraph.PolicyTypeClaimsMapping, "claims mapping policy", rendered); diags.HasError() {
+		return diags
+	}
+
+	return claimsMappingPolicyResourceRead(ctx, d, meta)
+}
+
+func claimsMappingPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var definition *string
+	if d.HasChanges("claim_schema", "claims_transformation", "include_basic_claim_set") {
+		rendered, err := claimsMappingPolicyRenderFromResource(d)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "claim_schema", "Rendering claims mapping policy definition")
+		}
+		definition = &rendered
+	}
+
+	if diags := policyResourceUpdate(ctx, d, meta, msgraph.PolicyTypeClaimsMapping, "claims mapping policy", definition); diags.HasError() {
+		return diags
+	}
+
+	return claimsMappingPolicyResourceRead(ctx, d, meta)
+}
+
+func claimsMappingPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policy, diags := policyResourceRead(ctx, d, meta, msgraph.PolicyTypeClaimsMapping, "claims mapping policy")
+	if policy == nil {
+		return diags
+	}
+
+	// the definition is decoded and compared structurally, so that differences in formatting are not reported as drift
+	var definition claimsMappingPolicyDefinition
+	if err := policyDefinitionDecode(policy, &definition); err != nil {
+		return tf.ErrorDiagPathF(err, "definition", "Parsing definition for claims mapping policy with ID %q", d.Id())
+	}
+
+	rendered, err := renderClaimsMappingPolicyDefinition(definition)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "definition", "Rendering definition for claims mapping policy with ID %q", d.Id())
+	}
+
+	tf.Set(d, "claim_schema", flattenClaimsMappingSchema(definition.ClaimsMappingPolicy.ClaimsSchema))
+	tf.Set(d, "claims_transformation", flattenClaimsMappingTransformations(definition.ClaimsMappingPolicy.ClaimsTransformations))
+	tf.Set(d, "definition", rendered)
+	tf.Set(d, "include_basic_claim_set", bool(definition.ClaimsMappingPolicy.IncludeBasicClaimSet))
+
+	return nil
+}
+
+func claimsMappingPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return policyResourceDelete(ctx, d, meta, msgraph.PolicyTypeClaimsMapping, "claims mapping policy")
+}
+
+func claimsMappingPolicyRenderFromResource(d *schema.ResourceData) (string, error) {
+	definition := expandClaimsMappingPolicyDefinition(
+		d.Get("include_basic_claim_set").(bool),
+		d.Get("claim_schema").(*schema.Set).List(),
+		d.Get("claims_transformation").(*schema.Set).List())
+
+	if err := validateClaimsMappingPolicyDefinition(definition); err != nil {
+		return "", err
+	}
+
+	return renderClaimsMappingPolicyDefinition(definition)
+}
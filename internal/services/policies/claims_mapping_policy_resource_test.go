@@ -0,0 +1,168 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+type ClaimsMappingPolicyResource struct{}
+
+func TestAccClaimsMappingPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_claims_mapping_policy", "test")
+	r := ClaimsMappingPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claim_schema.#").HasValue("1"),
+				check.That(data.ResourceName).Key("definition").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccClaimsMappingPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_claims_mapping_policy", "test")
+	r := ClaimsMappingPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("claim_schema.#").HasValue("4"),
+				check.That(data.ResourceName).Key("claims_transformation.#").HasValue("1"),
+				check.That(data.ResourceName).Key("include_basic_claim_set").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccClaimsMappingPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_claims_mapping_policy", "test")
+	r := ClaimsMappingPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ClaimsMappingPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	resp, err := clients.Policies.MsGraphClient.Get(ctx, msgraph.PolicyTypeClaimsMapping, state.ID)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("Claims Mapping Policy with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Claims Mapping Policy with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(resp.ID != nil && *resp.ID == state.ID), nil
+}
+
+func (ClaimsMappingPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_claims_mapping_policy" "test" {
+  display_name = "acctest-ClaimsMappingPolicy-%[1]d"
+
+  claim_schema {
+    source         = "user"
+    id             = "employeeid"
+    jwt_claim_type = "employeeid"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (ClaimsMappingPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_claims_mapping_policy" "test" {
+  display_name            = "acctest-ClaimsMappingPolicy-%[1]d"
+  description             = "Acceptance test claims mapping policy"
+  include_basic_claim_set = true
+
+  claim_schema {
+    source          = "user"
+    id              = "employeeid"
+    jwt_claim_type  = "employeeid"
+    saml_claim_type = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/employeeid"
+  }
+
+  claim_schema {
+    source         = "company"
+    id             = "tenantcountry"
+    jwt_claim_type = "country"
+  }
+
+  claim_schema {
+    value          = "sandbox"
+    jwt_claim_type = "environment"
+  }
+
+  claim_schema {
+    source            = "transformation"
+    id                = "DataJoin"
+    transformation_id = "JoinTheData"
+    jwt_claim_type    = "JoinedData"
+  }
+
+  claims_transformation {
+    id                    = "JoinTheData"
+    transformation_method = "Join"
+
+    input_claim {
+      claim_type_reference_id   = "employeeid"
+      transformation_claim_type = "string1"
+    }
+
+    input_claim {
+      claim_type_reference_id   = "tenantcountry"
+      transformation_claim_type = "string2"
+    }
+
+    input_parameter {
+      id    = "separator"
+      value = "."
+    }
+
+    output_claim {
+      claim_type_reference_id   = "DataJoin"
+      transformation_claim_type = "outputClaim"
+    }
+  }
+}
+`, data.RandomInteger)
+}
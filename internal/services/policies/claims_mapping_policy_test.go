@@ -0,0 +1,245 @@
+package policies
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidateClaimsMappingPolicyDefinition(t *testing.T) {
+	cases := []struct {
+		Name            string
+		Schema          []claimsMappingSchemaEntry
+		Transformations []claimsMappingTransformation
+		Error           bool
+	}{
+		{
+			Name: "source with id",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "user", ID: "employeeid", JwtClaimType: "employee_id"},
+			},
+		},
+		{
+			Name: "source with extension id",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "user", ExtensionID: "extension_00000000000000000000000000000000_costCenter", SamlClaimType: "cost_center"},
+			},
+		},
+		{
+			Name: "value only",
+			Schema: []claimsMappingSchemaEntry{
+				{Value: "sandbox", JwtClaimType: "environment"},
+			},
+		},
+		{
+			Name: "transformation",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "user", ID: "givenname", JwtClaimType: "given_name"},
+				{Source: "user", ID: "surname", JwtClaimType: "family_name"},
+				{Source: "Transformation", ID: "DataJoin", TransformationID: "JoinTheData", JwtClaimType: "full_name"},
+			},
+			Transformations: []claimsMappingTransformation{
+				{
+					ID:                   "JoinTheData",
+					TransformationMethod: "Join",
+					InputClaims: []claimsMappingTransformClaim{
+						{ClaimTypeReferenceID: "givenname", TransformationClaimType: "string1"},
+						{ClaimTypeReferenceID: "surname", TransformationClaimType: "string2"},
+					},
+					OutputClaims: []claimsMappingTransformClaim{
+						{ClaimTypeReferenceID: "DataJoin", TransformationClaimType: "outputClaim"},
+					},
+				},
+			},
+		},
+		{
+			Name: "duplicate transformation id",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "user", ID: "givenname", JwtClaimType: "given_name"},
+				{Source: "transformation", ID: "DataJoin", TransformationID: "JoinTheData", JwtClaimType: "full_name"},
+			},
+			Transformations: []claimsMappingTransformation{
+				{
+					ID:                   "JoinTheData",
+					TransformationMethod: "Join",
+					OutputClaims:         []claimsMappingTransformClaim{{ClaimTypeReferenceID: "DataJoin", TransformationClaimType: "outputClaim"}},
+				},
+				{
+					ID:                   "JoinTheData",
+					TransformationMethod: "Join",
+					OutputClaims:         []claimsMappingTransformClaim{{ClaimTypeReferenceID: "DataJoin", TransformationClaimType: "outputClaim"}},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "transformation without output claim",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "transformation", ID: "DataJoin", TransformationID: "JoinTheData", JwtClaimType: "full_name"},
+			},
+			Transformations: []claimsMappingTransformation{
+				{ID: "JoinTheData", TransformationMethod: "Join"},
+			},
+			Error: true,
+		},
+		{
+			Name: "dangling claim type reference id",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "transformation", ID: "DataJoin", TransformationID: "JoinTheData", JwtClaimType: "full_name"},
+			},
+			Transformations: []claimsMappingTransformation{
+				{
+					ID:                   "JoinTheData",
+					TransformationMethod: "Join",
+					InputClaims:          []claimsMappingTransformClaim{{ClaimTypeReferenceID: "givenname", TransformationClaimType: "string1"}},
+					OutputClaims:         []claimsMappingTransformClaim{{ClaimTypeReferenceID: "DataJoin", TransformationClaimType: "outputClaim"}},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "dangling transformation id",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "transformation", ID: "DataJoin", TransformationID: "JoinTheData", JwtClaimType: "full_name"},
+			},
+			Error: true,
+		},
+		{
+			Name: "transformation source without transformation id",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "transformation", ID: "DataJoin", JwtClaimType: "full_name"},
+			},
+			Error: true,
+		},
+		{
+			Name: "transformation id without transformation source",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "user", ID: "givenname", TransformationID: "JoinTheData", JwtClaimType: "given_name"},
+			},
+			Error: true,
+		},
+		{
+			Name: "value combined with source",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "user", Value: "sandbox", JwtClaimType: "environment"},
+			},
+			Error: true,
+		},
+		{
+			Name: "neither source nor value",
+			Schema: []claimsMappingSchemaEntry{
+				{ID: "employeeid", JwtClaimType: "employee_id"},
+			},
+			Error: true,
+		},
+		{
+			Name: "neither id nor extension id",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "user", JwtClaimType: "employee_id"},
+			},
+			Error: true,
+		},
+		{
+			Name: "both id and extension id",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "user", ID: "employeeid", ExtensionID: "extension_00000000000000000000000000000000_costCenter", JwtClaimType: "employee_id"},
+			},
+			Error: true,
+		},
+		{
+			Name: "no claim type",
+			Schema: []claimsMappingSchemaEntry{
+				{Source: "user", ID: "employeeid"},
+			},
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			definition := claimsMappingPolicyDefinition{
+				ClaimsMappingPolicy: claimsMappingPolicy{
+					Version:               1,
+					ClaimsSchema:          tc.Schema,
+					ClaimsTransformations: tc.Transformations,
+				},
+			}
+
+			err := validateClaimsMappingPolicyDefinition(definition)
+			if err != nil && !tc.Error {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if err == nil && tc.Error {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
+
+func TestRenderClaimsMappingPolicyDefinition(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "sources are lowercased",
+			Input:    `{"ClaimsMappingPolicy":{"Version":1,"IncludeBasicClaimSet":"true","ClaimsSchema":[{"Source":"User","ID":"employeeid","JwtClaimType":"employee_id"},{"Source":"TRANSFORMATION","ID":"DataJoin","TransformationId":"JoinTheData","JwtClaimType":"full_name"}]}}`,
+			Expected: `{"ClaimsMappingPolicy":{"Version":1,"IncludeBasicClaimSet":"true","ClaimsSchema":[{"Source":"user","ID":"employeeid","JwtClaimType":"employee_id"},{"Source":"transformation","ID":"DataJoin","JwtClaimType":"full_name","TransformationId":"JoinTheData"}]}}`,
+		},
+		{
+			Name:     "field names are case-insensitive",
+			Input:    `{"claimsMappingPolicy":{"version":1,"includeBasicClaimSet":"false","claimsSchema":[{"source":"user","id":"employeeid","samlClaimType":"employee_id"}]}}`,
+			Expected: `{"ClaimsMappingPolicy":{"Version":1,"IncludeBasicClaimSet":"false","ClaimsSchema":[{"Source":"user","ID":"employeeid","SamlClaimType":"employee_id"}]}}`,
+		},
+		{
+			Name:     "boolean written as JSON boolean",
+			Input:    `{"ClaimsMappingPolicy":{"Version":1,"IncludeBasicClaimSet":true,"ClaimsSchema":[{"Value":"sandbox","JwtClaimType":"environment"}]}}`,
+			Expected: `{"ClaimsMappingPolicy":{"Version":1,"IncludeBasicClaimSet":"true","ClaimsSchema":[{"Value":"sandbox","JwtClaimType":"environment"}]}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var definition claimsMappingPolicyDefinition
+			if err := json.Unmarshal([]byte(tc.Input), &definition); err != nil {
+				t.Fatalf("unmarshaling input: %+v", err)
+			}
+
+			result, err := renderClaimsMappingPolicyDefinition(definition)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if result != tc.Expected {
+				t.Fatalf("expected %s, got %s", tc.Expected, result)
+			}
+		})
+	}
+}
+
+func TestRenderClaimsMappingPolicyDefinition_equivalent(t *testing.T) {
+	render := func(source string) string {
+		definition := expandClaimsMappingPolicyDefinition(true, []interface{}{
+			map[string]interface{}{
+				"source":            source,
+				"id":                "employeeid",
+				"extension_id":      "",
+				"value":             "",
+				"jwt_claim_type":    "employee_id",
+				"saml_claim_type":   "",
+				"saml_name_format":  "",
+				"transformation_id": "",
+			},
+		}, nil)
+
+		result, err := renderClaimsMappingPolicyDefinition(definition)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		return result
+	}
+
+	if lower, mixed := render("user"), render("User"); lower != mixed {
+		t.Fatalf("expected definitions to render identically, got %s and %s", lower, mixed)
+	}
+}
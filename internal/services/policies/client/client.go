@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/terraform-providers/terraform-provider-azuread/internal/common"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+)
+
+type Client struct {
+	MsGraphClient *msgraph.PoliciesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	msGraphClient := msgraph.NewPoliciesClientWithBaseURI(o.MsGraphEndpoint)
+	o.ConfigureClient(&msGraphClient.Client, o.MsGraphAuthorizer)
+
+	return &Client{
+		MsGraphClient: &msGraphClient,
+	}
+}
@@ -0,0 +1,149 @@
+package policies
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+type homeRealmDiscoveryPolicyDefinition struct {
+	HomeRealmDiscoveryPolicy struct {
+		AccelerateToFederatedDomain  bool   `json:"AccelerateToFederatedDomain"`
+		AllowCloudPasswordValidation bool   `json:"AllowCloudPasswordValidation"`
+		PreferredDomain              string `json:"PreferredDomain,omitempty"`
+		AlternateIdLogin             struct {
+			Enabled bool `json:"Enabled"`
+		} `json:"AlternateIdLogin"`
+	} `json:"HomeRealmDiscoveryPolicy"`
+}
+
+func homeRealmDiscoveryPolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: homeRealmDiscoveryPolicyResourceCreate,
+		ReadContext:   homeRealmDiscoveryPolicyResourceRead,
+		UpdateContext: homeRealmDiscoveryPolicyResourceUpdate,
+		DeleteContext: homeRealmDiscoveryPolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: policyResourceImporter,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"accelerate_to_federated_domain": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allow_cloud_password_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"alternate_id_login_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"is_organization_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"preferred_domain": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func homeRealmDiscoveryPolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	definition, err := homeRealmDiscoveryPolicyRenderFromResource(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Rendering home realm discovery policy definition")
+	}
+
+	if diags := policyResourceCreate(ctx, d, meta, msgraph.PolicyTypeHomeRealmDiscovery, "home realm discovery policy", definition); diags.HasError() {
+		return diags
+	}
+
+	return homeRealmDiscoveryPolicyResourceRead(ctx, d, meta)
+}
+
+func homeRealmDiscoveryPolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var definition *string
+	if d.HasChanges("accelerate_to_federated_domain", "allow_cloud_password_validation", "alternate_id_login_enabled", "preferred_domain") {
+		rendered, err := homeRealmDiscoveryPolicyRenderFromResource(d)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Rendering home realm discovery policy definition")
+		}
+		definition = &rendered
+	}
+
+	if diags := policyResourceUpdate(ctx, d, meta, msgraph.PolicyTypeHomeRealmDiscovery, "home realm discovery policy", definition); diags.HasError() {
+		return diags
+	}
+
+	return homeRealmDiscoveryPolicyResourceRead(ctx, d, meta)
+}
+
+func homeRealmDiscoveryPolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policy, diags := policyResourceRead(ctx, d, meta, msgraph.PolicyTypeHomeRealmDiscovery, "home realm discovery policy")
+	if policy == nil {
+		return diags
+	}
+
+	var definition homeRealmDiscoveryPolicyDefinition
+	if err := policyDefinitionDecode(policy, &definition); err != nil {
+		return tf.ErrorDiagF(err, "Parsing definition for home realm discovery policy with ID %q", d.Id())
+	}
+
+	tf.Set(d, "accelerate_to_federated_domain", definition.HomeRealmDiscoveryPolicy.AccelerateToFederatedDomain)
+	tf.Set(d, "allow_cloud_password_validation", definition.HomeRealmDiscoveryPolicy.AllowCloudPasswordValidation)
+	tf.Set(d, "alternate_id_login_enabled", definition.HomeRealmDiscoveryPolicy.AlternateIdLogin.Enabled)
+	tf.Set(d, "is_organization_default", policyIsOrganizationDefault(policy))
+	tf.Set(d, "preferred_domain", definition.HomeRealmDiscoveryPolicy.PreferredDomain)
+
+	return nil
+}
+
+func homeRealmDiscoveryPolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return policyResourceDelete(ctx, d, meta, msgraph.PolicyTypeHomeRealmDiscovery, "home realm discovery policy")
+}
+
+func homeRealmDiscoveryPolicyRenderFromResource(d *schema.ResourceData) (string, error) {
+	var definition homeRealmDiscoveryPolicyDefinition
+	definition.HomeRealmDiscoveryPolicy.AccelerateToFederatedDomain = d.Get("accelerate_to_federated_domain").(bool)
+	definition.HomeRealmDiscoveryPolicy.AllowCloudPasswordValidation = d.Get("allow_cloud_password_validation").(bool)
+	definition.HomeRealmDiscoveryPolicy.AlternateIdLogin.Enabled = d.Get("alternate_id_login_enabled").(bool)
+	definition.HomeRealmDiscoveryPolicy.PreferredDomain = d.Get("preferred_domain").(string)
+
+	return policyDefinitionEncode(definition)
+}
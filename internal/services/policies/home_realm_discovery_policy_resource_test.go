@@ -0,0 +1,100 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+type HomeRealmDiscoveryPolicyResource struct{}
+
+func TestAccHomeRealmDiscoveryPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_home_realm_discovery_policy", "test")
+	r := HomeRealmDiscoveryPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("accelerate_to_federated_domain").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHomeRealmDiscoveryPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_home_realm_discovery_policy", "test")
+	r := HomeRealmDiscoveryPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("accelerate_to_federated_domain").HasValue("true"),
+				check.That(data.ResourceName).Key("allow_cloud_password_validation").HasValue("true"),
+				check.That(data.ResourceName).Key("alternate_id_login_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("preferred_domain").HasValue("federated.example.com"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r HomeRealmDiscoveryPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	resp, err := clients.Policies.MsGraphClient.Get(ctx, msgraph.PolicyTypeHomeRealmDiscovery, state.ID)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("Home Realm Discovery Policy with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Home Realm Discovery Policy with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(resp.ID != nil && *resp.ID == state.ID), nil
+}
+
+func (HomeRealmDiscoveryPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_home_realm_discovery_policy" "test" {
+  display_name = "acctest-HomeRealmDiscoveryPolicy-%[1]d"
+}
+`, data.RandomInteger)
+}
+
+func (HomeRealmDiscoveryPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_home_realm_discovery_policy" "test" {
+  display_name                    = "acctest-HomeRealmDiscoveryPolicy-%[1]d"
+  description                     = "Acceptance test home realm discovery policy"
+  accelerate_to_federated_domain  = true
+  allow_cloud_password_validation = true
+  alternate_id_login_enabled      = true
+  preferred_domain                = "federated.example.com"
+}
+`, data.RandomInteger)
+}
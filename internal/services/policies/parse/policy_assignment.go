@@ -0,0 +1,34 @@
+package parse
+
+import "fmt"
+
+type PolicyAssignmentId struct {
+	ObjectId   string
+	PolicyType string
+	PolicyId   string
+}
+
+func NewPolicyAssignmentID(objectId, policyType, policyId string) PolicyAssignmentId {
+	return PolicyAssignmentId{
+		ObjectId:   objectId,
+		PolicyType: policyType,
+		PolicyId:   policyId,
+	}
+}
+
+func (id PolicyAssignmentId) String() string {
+	return id.ObjectId + "/" + id.PolicyType + "/" + id.PolicyId
+}
+
+func PolicyAssignmentID(idString, policyType string) (*PolicyAssignmentId, error) {
+	id, err := ObjectSubResourceID(idString, policyType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Policy Assignment ID: %v", err)
+	}
+
+	return &PolicyAssignmentId{
+		ObjectId:   id.objectId,
+		PolicyType: id.Type,
+		PolicyId:   id.subId,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package policies
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/policies/parse"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+// policyAssignment describes a resource which manages the assignment of a type of policy to an application or service
+// principal. The resource ID is of the form `{objectId}/{idType}/{policyId}`.
+type policyAssignment struct {
+	resourceName string
+
+	objectType  msgraph.PolicyObjectType
+	objectField string
+	objectName  string
+
+	policyType  msgraph.PolicyType
+	policyField string
+	policyName  string
+
+	idType string
+}
+
+func (a policyAssignment) resource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: a.create,
+		ReadContext:   a.read,
+		DeleteContext: a.delete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.PolicyAssignmentID(id, a.idType)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			a.objectField: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			a.policyField: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func (a policyAssignment) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.MsGraphClient
+
+	id := parse.NewPolicyAssignmentID(d.Get(a.objectField).(string), a.idType, d.Get(a.policyField).(string))
+
+	assigned, err := client.ListAssigned(ctx, a.objectType, id.ObjectId, a.policyType)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, a.objectField, "Listing %ss for %s with object ID %q", a.policyName, a.objectName, id.ObjectId)
+	}
+	if policyAssignmentFind(assigned, id.PolicyId) {
+		return tf.ImportAsExistsDiag(a.resourceName, id.String())
+	}
+
+	if _, err := client.Assign(ctx, a.objectType, id.ObjectId, a.policyType, id.PolicyId); err != nil {
+		return tf.ErrorDiagF(err, "Assigning %s %q to %s with object ID %q", a.policyName, id.PolicyId, a.objectName, id.ObjectId)
+	}
+
+	d.SetId(id.String())
+
+	return a.read(ctx, d, meta)
+}
+
+func (a policyAssignment) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.MsGraphClient
+
+	id, err := parse.PolicyAssignmentID(d.Id(), a.idType)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing %s assignment with ID %q", a.policyName, d.Id())
+	}
+
+	assigned, err := client.ListAssigned(ctx, a.objectType, id.ObjectId, a.policyType)
+	if err != nil {
+		if utils.ResponseWasNotFound(assigned.Response) {
+			log.Printf("[DEBUG] %s with Object ID %q was not found - removing from state!", a.objectName, id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, a.objectField, "Listing %ss for %s with object ID %q", a.policyName, a.objectName, id.ObjectId)
+	}
+
+	if !policyAssignmentFind(assigned, id.PolicyId) {
+		log.Printf("[DEBUG] %s %q is not assigned to %s %q - removing from state!", a.policyName, id.PolicyId, a.objectName, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, a.objectField, id.ObjectId)
+	tf.Set(d, a.policyField, id.PolicyId)
+
+	return nil
+}
+
+func (a policyAssignment) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.MsGraphClient
+
+	id, err := parse.PolicyAssignmentID(d.Id(), a.idType)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing %s assignment with ID %q", a.policyName, d.Id())
+	}
+
+	resp, err := client.Unassign(ctx, a.objectType, id.ObjectId, a.policyType, id.PolicyId)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return tf.ErrorDiagF(err, "Removing %s %q from %s with object ID %q", a.policyName, id.PolicyId, a.objectName, id.ObjectId)
+		}
+	}
+
+	return nil
+}
+
+// policyAssignmentFind returns whether the policy with the specified ID is present in a list of assigned policies
+func policyAssignmentFind(assigned msgraph.PolicyListResult, policyId string) bool {
+	if assigned.Value != nil {
+		for _, p := range *assigned.Value {
+			if p.ID != nil && strings.EqualFold(*p.ID, policyId) {
+				return true
+			}
+		}
+	}
+	return false
+}
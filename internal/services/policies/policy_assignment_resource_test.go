@@ -0,0 +1,36 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/policies/parse"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+func policyAssignmentExists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState, objectType msgraph.PolicyObjectType, policyType msgraph.PolicyType, idType string) (*bool, error) {
+	id, err := parse.PolicyAssignmentID(state.ID, idType)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Policy Assignment ID: %v", err)
+	}
+
+	resp, err := clients.Policies.MsGraphClient.ListAssigned(ctx, objectType, id.ObjectId, policyType)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("Object with ID %q does not exist", id.ObjectId)
+		}
+		return nil, fmt.Errorf("failed to list %s for object %q: %+v", policyType, id.ObjectId, err)
+	}
+
+	for _, policyId := range msgraph.PolicyIDs(resp) {
+		if policyId == id.PolicyId {
+			return utils.Bool(true), nil
+		}
+	}
+
+	return nil, fmt.Errorf("Policy %q was not assigned to object %q", id.PolicyId, id.ObjectId)
+}
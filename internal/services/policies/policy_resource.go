@@ -0,0 +1,151 @@
+package policies
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+// policyResourceImporter validates the ID of a policy being imported
+var policyResourceImporter = tf.ValidateResourceIDPriorToImport(func(id string) error {
+	if _, err := uuid.ParseUUID(id); err != nil {
+		return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+	}
+	return nil
+})
+
+// policyResourceCreate creates a policy from the `display_name`, `description` and (where supported)
+// `is_organization_default` properties and the provided JSON definition, and sets the resource ID
+func policyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, policyType msgraph.PolicyType, policyName, definition string) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.MsGraphClient
+
+	properties := msgraph.Policy{
+		Definition:  &[]string{definition},
+		DisplayName: utils.String(d.Get("display_name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		properties.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("is_organization_default"); ok {
+		properties.IsOrganizationDefault = utils.Bool(v.(bool))
+	}
+
+	policy, err := client.Create(ctx, policyType, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create %s", policyName)
+	}
+	if policy.ID == nil || *policy.ID == "" {
+		return tf.ErrorDiagF(fmt.Errorf("ID returned for %s is nil/empty", policyName), "Bad API response")
+	}
+
+	d.SetId(*policy.ID)
+
+	return nil
+}
+
+// policyResourceUpdate updates the common properties of a policy, along with its JSON definition when one is provided
+func policyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, policyType msgraph.PolicyType, policyName string, definition *string) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.MsGraphClient
+
+	properties := msgraph.Policy{
+		ID: utils.String(d.Id()),
+	}
+
+	if d.HasChange("display_name") {
+		properties.DisplayName = utils.String(d.Get("display_name").(string))
+	}
+
+	if d.HasChange("description") {
+		properties.Description = utils.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("is_organization_default") {
+		properties.IsOrganizationDefault = utils.Bool(d.Get("is_organization_default").(bool))
+	}
+
+	if definition != nil {
+		properties.Definition = &[]string{*definition}
+	}
+
+	if _, err := client.Update(ctx, policyType, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s with ID %q", policyName, d.Id())
+	}
+
+	return nil
+}
+
+// policyResourceRead retrieves a policy and sets its `display_name` and `description` properties. A nil policy is
+// returned when the policy was not found, in which case the resource has been removed from state.
+func policyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}, policyType msgraph.PolicyType, policyName string) (*msgraph.Policy, diag.Diagnostics) {
+	client := meta.(*clients.Client).Policies.MsGraphClient
+
+	policy, err := client.Get(ctx, policyType, d.Id())
+	if err != nil {
+		if utils.ResponseWasNotFound(policy.Response) {
+			log.Printf("[DEBUG] %s with ID %q was not found - removing from state", policyName, d.Id())
+			d.SetId("")
+			return nil, nil
+		}
+		return nil, tf.ErrorDiagPathF(err, "id", "Retrieving %s with ID %q", policyName, d.Id())
+	}
+
+	tf.Set(d, "description", policy.Description)
+	tf.Set(d, "display_name", policy.DisplayName)
+
+	return &policy, nil
+}
+
+// policyResourceDelete deletes a policy
+func policyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, policyType msgraph.PolicyType, policyName string) diag.Diagnostics {
+	client := meta.(*clients.Client).Policies.MsGraphClient
+
+	resp, err := client.Delete(ctx, policyType, d.Id())
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return tf.ErrorDiagF(err, "Deleting %s with ID %q", policyName, d.Id())
+		}
+	}
+
+	return nil
+}
+
+// policyDefinitionDecode decodes the JSON definition of a policy into the provided value
+func policyDefinitionDecode(policy *msgraph.Policy, v interface{}) error {
+	if policy.Definition == nil || len(*policy.Definition) == 0 {
+		return fmt.Errorf("policy definition was empty")
+	}
+
+	if err := json.Unmarshal([]byte((*policy.Definition)[0]), v); err != nil {
+		return fmt.Errorf("decoding policy definition: %+v", err)
+	}
+
+	return nil
+}
+
+// policyDefinitionEncode encodes the provided value as the JSON definition of a policy
+func policyDefinitionEncode(v interface{}) (string, error) {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("encoding policy definition: %+v", err)
+	}
+
+	return string(out), nil
+}
+
+// policyIsOrganizationDefault returns whether a policy applies to all objects in the tenant which do not have another
+// policy of the same type assigned
+func policyIsOrganizationDefault(policy *msgraph.Policy) bool {
+	return policy.IsOrganizationDefault != nil && *policy.IsOrganizationDefault
+}
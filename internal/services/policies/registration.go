@@ -0,0 +1,38 @@
+package policies
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Policies"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Policies",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azuread_activity_based_timeout_policy":                            activityBasedTimeoutPolicyResource(),
+		"azuread_application_token_lifetime_policy_assignment":             applicationTokenLifetimePolicyAssignmentResource(),
+		"azuread_claims_mapping_policy":                                    claimsMappingPolicyResource(),
+		"azuread_home_realm_discovery_policy":                              homeRealmDiscoveryPolicyResource(),
+		"azuread_service_principal_claims_mapping_policy_assignment":       servicePrincipalClaimsMappingPolicyAssignmentResource(),
+		"azuread_service_principal_home_realm_discovery_policy_assignment": servicePrincipalHomeRealmDiscoveryPolicyAssignmentResource(),
+		"azuread_service_principal_token_lifetime_policy_assignment":       servicePrincipalTokenLifetimePolicyAssignmentResource(),
+		"azuread_token_lifetime_policy":                                    tokenLifetimePolicyResource(),
+	}
+}
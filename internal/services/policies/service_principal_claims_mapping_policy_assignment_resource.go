@@ -0,0 +1,20 @@
+package policies
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+)
+
+func servicePrincipalClaimsMappingPolicyAssignmentResource() *schema.Resource {
+	return policyAssignment{
+		resourceName: "azuread_service_principal_claims_mapping_policy_assignment",
+		objectType:   msgraph.PolicyObjectTypeServicePrincipal,
+		objectField:  "service_principal_id",
+		objectName:   "service principal",
+		policyType:   msgraph.PolicyTypeClaimsMapping,
+		policyField:  "claims_mapping_policy_id",
+		policyName:   "claims mapping policy",
+		idType:       "claimsMappingPolicy",
+	}.resource()
+}
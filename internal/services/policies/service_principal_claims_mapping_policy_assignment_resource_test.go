@@ -0,0 +1,89 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+)
+
+type ServicePrincipalClaimsMappingPolicyAssignmentResource struct{}
+
+func TestAccServicePrincipalClaimsMappingPolicyAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_claims_mapping_policy_assignment", "test")
+	r := ServicePrincipalClaimsMappingPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipalClaimsMappingPolicyAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_claims_mapping_policy_assignment", "test")
+	r := ServicePrincipalClaimsMappingPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r ServicePrincipalClaimsMappingPolicyAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	return policyAssignmentExists(ctx, clients, state, msgraph.PolicyObjectTypeServicePrincipal, msgraph.PolicyTypeClaimsMapping, "claimsMappingPolicy")
+}
+
+func (ServicePrincipalClaimsMappingPolicyAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_claims_mapping_policy" "test" {
+  display_name = "acctest-ClaimsMappingPolicy-%[1]d"
+
+  claim_schema {
+    source         = "user"
+    id             = "employeeid"
+    jwt_claim_type = "employeeid"
+  }
+}
+
+resource "azuread_service_principal_claims_mapping_policy_assignment" "test" {
+  service_principal_id     = azuread_service_principal.test.id
+  claims_mapping_policy_id = azuread_claims_mapping_policy.test.id
+}
+`, data.RandomInteger)
+}
+
+func (r ServicePrincipalClaimsMappingPolicyAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_claims_mapping_policy_assignment" "import" {
+  service_principal_id     = azuread_service_principal_claims_mapping_policy_assignment.test.service_principal_id
+  claims_mapping_policy_id = azuread_service_principal_claims_mapping_policy_assignment.test.claims_mapping_policy_id
+}
+`, r.basic(data))
+}
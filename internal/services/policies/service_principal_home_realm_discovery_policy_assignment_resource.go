@@ -0,0 +1,20 @@
+package policies
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+)
+
+func servicePrincipalHomeRealmDiscoveryPolicyAssignmentResource() *schema.Resource {
+	return policyAssignment{
+		resourceName: "azuread_service_principal_home_realm_discovery_policy_assignment",
+		objectType:   msgraph.PolicyObjectTypeServicePrincipal,
+		objectField:  "service_principal_id",
+		objectName:   "service principal",
+		policyType:   msgraph.PolicyTypeHomeRealmDiscovery,
+		policyField:  "home_realm_discovery_policy_id",
+		policyName:   "home realm discovery policy",
+		idType:       "homeRealmDiscoveryPolicy",
+	}.resource()
+}
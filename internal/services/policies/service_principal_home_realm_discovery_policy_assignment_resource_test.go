@@ -0,0 +1,64 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+)
+
+type ServicePrincipalHomeRealmDiscoveryPolicyAssignmentResource struct{}
+
+func TestAccServicePrincipalHomeRealmDiscoveryPolicyAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_home_realm_discovery_policy_assignment", "test")
+	r := ServicePrincipalHomeRealmDiscoveryPolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That("azuread_service_principal.test").Key("home_realm_discovery_policy_ids.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (r ServicePrincipalHomeRealmDiscoveryPolicyAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	return policyAssignmentExists(ctx, clients, state, msgraph.PolicyObjectTypeServicePrincipal, msgraph.PolicyTypeHomeRealmDiscovery, "homeRealmDiscoveryPolicy")
+}
+
+func (ServicePrincipalHomeRealmDiscoveryPolicyAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azuread_home_realm_discovery_policy" "test" {
+  display_name                   = "acctest-HomeRealmDiscoveryPolicy-%[1]d"
+  accelerate_to_federated_domain = true
+}
+
+resource "azuread_service_principal_home_realm_discovery_policy_assignment" "test" {
+  service_principal_id           = azuread_service_principal.test.id
+  home_realm_discovery_policy_id = azuread_home_realm_discovery_policy.test.id
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,96 @@
+package policies
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var timespanRegex = regexp.MustCompile(`^(?:(\d+)\.)?(\d{1,2}):(\d{2}):(\d{2})$`)
+
+// parseTimespan parses a duration in the timespan format used by policy definitions, i.e. `[days.]hh:mm:ss`
+func parseTimespan(in string) (time.Duration, error) {
+	m := timespanRegex.FindStringSubmatch(in)
+	if m == nil {
+		return 0, fmt.Errorf("%q is not a valid timespan, expected the format [days.]hh:mm:ss", in)
+	}
+
+	var days int
+	if m[1] != "" {
+		days, _ = strconv.Atoi(m[1])
+	}
+	hours, _ := strconv.Atoi(m[2])
+	minutes, _ := strconv.Atoi(m[3])
+	seconds, _ := strconv.Atoi(m[4])
+
+	if hours > 23 || minutes > 59 || seconds > 59 {
+		return 0, fmt.Errorf("%q is not a valid timespan, hours must be less than 24 and minutes and seconds less than 60", in)
+	}
+
+	return time.Duration(days)*24*time.Hour + time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second, nil
+}
+
+// validateTimespan returns a validation function which checks that a timespan falls within the specified range. When
+// max is zero, no upper bound is enforced.
+func validateTimespan(min, max time.Duration) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+		v, ok := i.(string)
+		if !ok {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Expected a string value",
+				AttributePath: path,
+			})
+			return
+		}
+
+		d, err := parseTimespan(v)
+		if err != nil {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Value must be a valid timespan",
+				Detail:        err.Error(),
+				AttributePath: path,
+			})
+			return
+		}
+
+		if d < min {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Value is out of range",
+				Detail:        fmt.Sprintf("Timespan must be at least %s, got %s", min, d),
+				AttributePath: path,
+			})
+		}
+
+		if max > 0 && d > max {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Value is out of range",
+				Detail:        fmt.Sprintf("Timespan must be at most %s, got %s", max, d),
+				AttributePath: path,
+			})
+		}
+
+		return
+	}
+}
+
+// timespanDiffSuppress suppresses differences between equivalent timespans, e.g. `1:00:00` and `01:00:00`
+func timespanDiffSuppress(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	o, err := parseTimespan(oldValue)
+	if err != nil {
+		return false
+	}
+	n, err := parseTimespan(newValue)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
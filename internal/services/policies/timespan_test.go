@@ -0,0 +1,200 @@
+package policies
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestParseTimespan(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected time.Duration
+		Error    bool
+	}{
+		{
+			Input:    "00:10:00",
+			Expected: 10 * time.Minute,
+		},
+		{
+			Input:    "1:00:00",
+			Expected: time.Hour,
+		},
+		{
+			Input:    "01:00:00",
+			Expected: time.Hour,
+		},
+		{
+			Input:    "23:59:59",
+			Expected: 24*time.Hour - time.Second,
+		},
+		{
+			Input:    "1.00:00:00",
+			Expected: 24 * time.Hour,
+		},
+		{
+			Input:    "90.12:30:15",
+			Expected: 90*24*time.Hour + 12*time.Hour + 30*time.Minute + 15*time.Second,
+		},
+		{
+			Input: "24:00:00",
+			Error: true,
+		},
+		{
+			Input: "00:60:00",
+			Error: true,
+		},
+		{
+			Input: "00:00:60",
+			Error: true,
+		},
+		{
+			Input: "00:1:00",
+			Error: true,
+		},
+		{
+			Input: "1.",
+			Error: true,
+		},
+		{
+			Input: "1h",
+			Error: true,
+		},
+		{
+			Input: "",
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			result, err := parseTimespan(tc.Input)
+			if err != nil {
+				if tc.Error {
+					return
+				}
+				t.Fatalf("unexpected error for %q: %+v", tc.Input, err)
+			}
+			if tc.Error {
+				t.Fatalf("expected an error for %q", tc.Input)
+			}
+
+			if result != tc.Expected {
+				t.Fatalf("expected %s for %q, got %s", tc.Expected, tc.Input, result)
+			}
+		})
+	}
+}
+
+func TestValidateTimespan(t *testing.T) {
+	cases := []struct {
+		Input  interface{}
+		Min    time.Duration
+		Max    time.Duration
+		Errors int
+	}{
+		{
+			Input:  "02:00:00",
+			Min:    10 * time.Minute,
+			Max:    24 * time.Hour,
+			Errors: 0,
+		},
+		{
+			Input:  "1.00:00:00",
+			Min:    10 * time.Minute,
+			Max:    24 * time.Hour,
+			Errors: 0,
+		},
+		{
+			Input:  "00:10:00",
+			Min:    10 * time.Minute,
+			Max:    24 * time.Hour,
+			Errors: 0,
+		},
+		{
+			Input:  "00:09:59",
+			Min:    10 * time.Minute,
+			Max:    24 * time.Hour,
+			Errors: 1,
+		},
+		{
+			Input:  "1.00:00:01",
+			Min:    10 * time.Minute,
+			Max:    24 * time.Hour,
+			Errors: 1,
+		},
+		{
+			Input:  "365.00:00:00",
+			Min:    time.Second,
+			Errors: 0,
+		},
+		{
+			Input:  "24:00:00",
+			Min:    10 * time.Minute,
+			Max:    24 * time.Hour,
+			Errors: 1,
+		},
+		{
+			Input:  "two hours",
+			Errors: 1,
+		},
+		{
+			Input:  7200,
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%v", tc.Input), func(t *testing.T) {
+			diags := validateTimespan(tc.Min, tc.Max)(tc.Input, cty.Path{})
+
+			if len(diags) != tc.Errors {
+				t.Fatalf("Expected validateTimespan to have %d not %d errors for %v", tc.Errors, len(diags), tc.Input)
+			}
+		})
+	}
+}
+
+func TestTimespanDiffSuppress(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Old:      "01:00:00",
+			New:      "1:00:00",
+			Suppress: true,
+		},
+		{
+			Old:      "1.00:00:00",
+			New:      "1.00:00:00",
+			Suppress: true,
+		},
+		{
+			Old:      "01:00:00",
+			New:      "02:00:00",
+			Suppress: false,
+		},
+		{
+			Old:      "",
+			New:      "01:00:00",
+			Suppress: false,
+		},
+		{
+			Old:      "24:00:00",
+			New:      "1.00:00:00",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Old+"/"+tc.New, func(t *testing.T) {
+			if result := timespanDiffSuppress("", tc.Old, tc.New, nil); result != tc.Suppress {
+				t.Fatalf("expected timespanDiffSuppress to return %t for %q and %q, got %t", tc.Suppress, tc.Old, tc.New, result)
+			}
+		})
+	}
+}
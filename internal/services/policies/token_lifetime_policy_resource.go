@@ -0,0 +1,123 @@
+package policies
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+type tokenLifetimePolicyDefinition struct {
+	TokenLifetimePolicy struct {
+		Version             int    `json:"Version"`
+		AccessTokenLifetime string `json:"AccessTokenLifetime,omitempty"`
+	} `json:"TokenLifetimePolicy"`
+}
+
+func tokenLifetimePolicyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: tokenLifetimePolicyResourceCreate,
+		ReadContext:   tokenLifetimePolicyResourceRead,
+		UpdateContext: tokenLifetimePolicyResourceUpdate,
+		DeleteContext: tokenLifetimePolicyResourceDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: policyResourceImporter,
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validate.NoEmptyStrings,
+			},
+
+			"access_token_lifetime": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateTimespan(10*time.Minute, 24*time.Hour),
+				DiffSuppressFunc: timespanDiffSuppress,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"is_organization_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func tokenLifetimePolicyResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	definition, err := tokenLifetimePolicyRenderFromResource(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Rendering token lifetime policy definition")
+	}
+
+	if diags := policyResourceCreate(ctx, d, meta, msgraph.PolicyTypeTokenLifetime, "token lifetime policy", definition); diags.HasError() {
+		return diags
+	}
+
+	return tokenLifetimePolicyResourceRead(ctx, d, meta)
+}
+
+func tokenLifetimePolicyResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var definition *string
+	if d.HasChange("access_token_lifetime") {
+		rendered, err := tokenLifetimePolicyRenderFromResource(d)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Rendering token lifetime policy definition")
+		}
+		definition = &rendered
+	}
+
+	if diags := policyResourceUpdate(ctx, d, meta, msgraph.PolicyTypeTokenLifetime, "token lifetime policy", definition); diags.HasError() {
+		return diags
+	}
+
+	return tokenLifetimePolicyResourceRead(ctx, d, meta)
+}
+
+func tokenLifetimePolicyResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	policy, diags := policyResourceRead(ctx, d, meta, msgraph.PolicyTypeTokenLifetime, "token lifetime policy")
+	if policy == nil {
+		return diags
+	}
+
+	var definition tokenLifetimePolicyDefinition
+	if err := policyDefinitionDecode(policy, &definition); err != nil {
+		return tf.ErrorDiagF(err, "Parsing definition for token lifetime policy with ID %q", d.Id())
+	}
+
+	tf.Set(d, "access_token_lifetime", definition.TokenLifetimePolicy.AccessTokenLifetime)
+	tf.Set(d, "is_organization_default", policyIsOrganizationDefault(policy))
+
+	return nil
+}
+
+func tokenLifetimePolicyResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return policyResourceDelete(ctx, d, meta, msgraph.PolicyTypeTokenLifetime, "token lifetime policy")
+}
+
+func tokenLifetimePolicyRenderFromResource(d *schema.ResourceData) (string, error) {
+	var definition tokenLifetimePolicyDefinition
+	definition.TokenLifetimePolicy.Version = 1
+	definition.TokenLifetimePolicy.AccessTokenLifetime = d.Get("access_token_lifetime").(string)
+
+	return policyDefinitionEncode(definition)
+}
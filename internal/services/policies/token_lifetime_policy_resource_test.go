@@ -0,0 +1,79 @@
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+type TokenLifetimePolicyResource struct{}
+
+func TestAccTokenLifetimePolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_token_lifetime_policy", "test")
+	r := TokenLifetimePolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "02:00:00"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("access_token_lifetime").HasValue("02:00:00"),
+				check.That(data.ResourceName).Key("is_organization_default").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccTokenLifetimePolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_token_lifetime_policy", "test")
+	r := TokenLifetimePolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "02:00:00"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "1.00:00:00"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r TokenLifetimePolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	resp, err := clients.Policies.MsGraphClient.Get(ctx, msgraph.PolicyTypeTokenLifetime, state.ID)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("Token Lifetime Policy with ID %q does not exist", state.ID)
+		}
+		return nil, fmt.Errorf("failed to retrieve Token Lifetime Policy with ID %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(resp.ID != nil && *resp.ID == state.ID), nil
+}
+
+func (TokenLifetimePolicyResource) basic(data acceptance.TestData, lifetime string) string {
+	return fmt.Sprintf(`
+resource "azuread_token_lifetime_policy" "test" {
+  display_name          = "acctest-TokenLifetimePolicy-%[1]d"
+  description           = "Acceptance test token lifetime policy"
+  access_token_lifetime = "%[2]s"
+}
+`, data.RandomInteger, lifetime)
+}
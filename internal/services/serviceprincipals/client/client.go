@@ -2,18 +2,31 @@ package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+
 	"github.com/terraform-providers/terraform-provider-azuread/internal/common"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
 )
 
 type Client struct {
 	AadClient *graphrbac.ServicePrincipalsClient
+
+	MsGraphClient  *msgraph.ServicePrincipalsClient
+	PoliciesClient *msgraph.PoliciesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	aadClient := graphrbac.NewServicePrincipalsClientWithBaseURI(o.AadGraphEndpoint, o.TenantID)
 	o.ConfigureClient(&aadClient.Client, o.AadGraphAuthorizer)
 
+	msGraphClient := msgraph.NewServicePrincipalsClientWithBaseURI(o.MsGraphEndpoint)
+	o.ConfigureClient(&msGraphClient.Client, o.MsGraphAuthorizer)
+
+	policiesClient := msgraph.NewPoliciesClientWithBaseURI(o.MsGraphEndpoint)
+	o.ConfigureClient(&policiesClient.Client, o.MsGraphAuthorizer)
+
 	return &Client{
-		AadClient: &aadClient,
+		AadClient:      &aadClient,
+		MsGraphClient:  &msGraphClient,
+		PoliciesClient: &policiesClient,
 	}
 }
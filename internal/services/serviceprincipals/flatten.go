@@ -0,0 +1,57 @@
+package serviceprincipals
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+)
+
+// flattenServicePrincipalExtendedProperties returns the enterprise application attributes for a service principal,
+// keyed by attribute name
+func flattenServicePrincipalExtendedProperties(sp graphrbac.ServicePrincipal) map[string]interface{} {
+	props := sp.AdditionalProperties
+
+	stringProp := func(key string) string {
+		if v, ok := props[key].(string); ok {
+			return v
+		}
+		return ""
+	}
+
+	// accountEnabled is null unless it has been explicitly set, in which case sign-in is enabled
+	accountEnabled := true
+	if sp.AccountEnabled != nil {
+		accountEnabled = *sp.AccountEnabled
+	}
+
+	homepageUrl := ""
+	if sp.Homepage != nil {
+		homepageUrl = *sp.Homepage
+	}
+
+	samlSingleSignOn := make([]interface{}, 0)
+	relayState := ""
+	if settings, ok := props["samlSingleSignOnSettings"].(map[string]interface{}); ok {
+		if v, ok := settings["relayState"].(string); ok {
+			relayState = v
+		}
+	}
+	if thumbprint := stringProp("preferredTokenSigningKeyThumbprint"); thumbprint != "" || relayState != "" {
+		samlSingleSignOn = append(samlSingleSignOn, map[string]interface{}{
+			"preferred_token_signing_key_thumbprint": thumbprint,
+			"relay_state":                            relayState,
+		})
+	}
+
+	return map[string]interface{}{
+		"account_enabled":               accountEnabled,
+		"alternative_names":             tf.FlattenStringSlicePtr(sp.AlternativeNames),
+		"homepage_url":                  homepageUrl,
+		"login_url":                     stringProp("loginUrl"),
+		"notes":                         stringProp("notes"),
+		"notification_email_addresses":  aadgraph.FlattenStringListProperty(props, "notificationEmailAddresses"),
+		"preferred_single_sign_on_mode": stringProp("preferredSingleSignOnMode"),
+		"saml_single_sign_on":           samlSingleSignOn,
+	}
+}
@@ -58,3 +58,16 @@ func OldPasswordID(id string) (*CredentialId, error) {
 	newId := parts[0] + "/password/" + parts[1]
 	return PasswordID(newId)
 }
+
+func TokenSigningCertificateID(idString string) (*CredentialId, error) {
+	id, err := ObjectSubResourceID(idString, "tokenSigningCertificate")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Token Signing Certificate ID: %v", err)
+	}
+
+	return &CredentialId{
+		ObjectId: id.objectId,
+		KeyType:  id.Type,
+		KeyId:    id.subId,
+	}, nil
+}
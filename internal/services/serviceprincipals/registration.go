@@ -29,8 +29,9 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azuread_service_principal":             servicePrincipalResource(),
-		"azuread_service_principal_certificate": servicePrincipalCertificateResource(),
-		"azuread_service_principal_password":    servicePrincipalPasswordResource(),
+		"azuread_service_principal":                           servicePrincipalResource(),
+		"azuread_service_principal_certificate":               servicePrincipalCertificateResource(),
+		"azuread_service_principal_password":                  servicePrincipalPasswordResource(),
+		"azuread_service_principal_token_signing_certificate": servicePrincipalTokenSigningCertificateResource(),
 	}
 }
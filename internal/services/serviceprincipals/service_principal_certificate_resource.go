@@ -50,21 +50,14 @@ func servicePrincipalCertificateResourceCreate(ctx context.Context, d *schema.Re
 	tf.LockByName(servicePrincipalResourceName, id.ObjectId)
 	defer tf.UnlockByName(servicePrincipalResourceName, id.ObjectId)
 
-	existingCreds, err := client.ListKeyCredentials(ctx, id.ObjectId)
-	if err != nil {
-		return tf.ErrorDiagPathF(err, "service_principal_id", "Listing certificate credentials for service principal with ID %q", objectId)
-	}
-
-	newCreds, err := aadgraph.KeyCredentialResultAdd(existingCreds, cred)
+	err = aadgraph.KeyCredentialsUpdate(ctx, client, id.ObjectId, d.Timeout(schema.TimeoutCreate), func(existing graphrbac.KeyCredentialListResult) (*[]graphrbac.KeyCredential, error) {
+		return aadgraph.KeyCredentialResultAdd(existing, cred)
+	})
 	if err != nil {
 		if _, ok := err.(*aadgraph.AlreadyExistsError); ok {
 			return tf.ImportAsExistsDiag("azuread_service_principal_certificate", id.String())
 		}
-		return tf.ErrorDiagF(err, "Adding service principal certificate")
-	}
-
-	if _, err = client.UpdateKeyCredentials(ctx, id.ObjectId, graphrbac.KeyCredentialsUpdateParameters{Value: newCreds}); err != nil {
-		return tf.ErrorDiagF(err, "Creating certificate credentials %q for service principal with object ID %q", id.KeyId, id.ObjectId)
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Creating certificate credentials %q for service principal with object ID %q", id.KeyId, id.ObjectId)
 	}
 
 	_, err = aadgraph.WaitForKeyCredentialReplication(ctx, id.KeyId, d.Timeout(schema.TimeoutCreate), func() (graphrbac.KeyCredentialListResult, error) {
@@ -157,18 +150,11 @@ func servicePrincipalCertificateResourceDelete(ctx context.Context, d *schema.Re
 		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving service principal with object ID %q", id.ObjectId)
 	}
 
-	existing, err := client.ListKeyCredentials(ctx, id.ObjectId)
-	if err != nil {
-		return tf.ErrorDiagF(err, "Listing certificate credentials for service principal with object ID %q", id.ObjectId)
-	}
-
-	newCreds, err := aadgraph.KeyCredentialResultRemoveByKeyId(existing, id.KeyId)
+	err = aadgraph.KeyCredentialsUpdate(ctx, client, id.ObjectId, d.Timeout(schema.TimeoutDelete), func(existing graphrbac.KeyCredentialListResult) (*[]graphrbac.KeyCredential, error) {
+		return aadgraph.KeyCredentialResultRemoveByKeyId(existing, id.KeyId)
+	})
 	if err != nil {
-		return tf.ErrorDiagF(err, "Removing certificate credential %q from service principal with object ID %q", id.KeyId, id.ObjectId)
-	}
-
-	if _, err = client.UpdateKeyCredentials(ctx, id.ObjectId, graphrbac.KeyCredentialsUpdateParameters{Value: newCreds}); err != nil {
-		return tf.ErrorDiagF(err, "Removing certificate credential %q from service principal with object ID %q", id.KeyId, id.ObjectId)
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Removing certificate credential %q from service principal with object ID %q", id.KeyId, id.ObjectId)
 	}
 
 	return nil
@@ -29,6 +29,11 @@ func servicePrincipalData() *schema.Resource {
 				ConflictsWith:    []string{"display_name", "application_id"},
 			},
 
+			"object_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"display_name": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -45,9 +50,84 @@ func servicePrincipalData() *schema.Resource {
 				ConflictsWith:    []string{"object_id", "display_name"},
 			},
 
+			"account_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"alternative_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"app_roles": schemaAppRolesComputed(),
 
+			"app_role_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"homepage_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"login_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"notes": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"notification_email_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"oauth2_permissions": schemaOauth2PermissionsComputed(),
+
+			"oauth2_permission_scope_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"preferred_single_sign_on_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"saml_single_sign_on": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preferred_token_signing_key_thumbprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"relay_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -127,10 +207,22 @@ func servicePrincipalDataRead(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(*sp.ObjectID)
 
 	tf.Set(d, "app_roles", aadgraph.FlattenAppRoles(sp.AppRoles))
+	tf.Set(d, "app_role_ids", aadgraph.FlattenAppRoleIDs(sp.AppRoles))
 	tf.Set(d, "application_id", sp.AppID)
 	tf.Set(d, "display_name", sp.DisplayName)
 	tf.Set(d, "oauth2_permissions", aadgraph.FlattenOauth2Permissions(sp.Oauth2Permissions))
+	tf.Set(d, "oauth2_permission_scope_ids", aadgraph.FlattenOauth2PermissionScopeIDs(sp.Oauth2Permissions))
 	tf.Set(d, "object_id", sp.ObjectID)
 
+	for k, v := range flattenServicePrincipalExtendedProperties(*sp) {
+		tf.Set(d, k, v)
+	}
+
+	objectJson, err := aadgraph.ServicePrincipalObjectJSON(ctx, client, *sp.ObjectID)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "object_json", "Retrieving JSON representation of service principal with object ID %q", *sp.ObjectID)
+	}
+	tf.Set(d, "object_json", objectJson)
+
 	return nil
 }
@@ -23,8 +23,11 @@ func TestAccServicePrincipalDataSource_byApplicationId(t *testing.T) {
 				check.That(data.ResourceName).Key("application_id").Exists(),
 				check.That(data.ResourceName).Key("object_id").Exists(),
 				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("object_json").Exists(),
 				check.That(data.ResourceName).Key("app_roles.#").HasValue("0"),
+				check.That(data.ResourceName).Key("app_role_ids.%").HasValue("0"),
 				check.That(data.ResourceName).Key("oauth2_permissions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.user_impersonation").IsUuid(),
 				check.That(data.ResourceName).Key("oauth2_permissions.0.admin_consent_description").HasValue(
 					fmt.Sprintf("Allow the application to access %s on behalf of the signed-in user.",
 						fmt.Sprintf("acctestServicePrincipal-%d", data.RandomInteger))),
@@ -45,7 +48,9 @@ func TestAccServicePrincipalDataSource_byDisplayName(t *testing.T) {
 				check.That(data.ResourceName).Key("object_id").Exists(),
 				check.That(data.ResourceName).Key("display_name").Exists(),
 				check.That(data.ResourceName).Key("app_roles.#").HasValue("0"),
+				check.That(data.ResourceName).Key("app_role_ids.%").HasValue("0"),
 				check.That(data.ResourceName).Key("oauth2_permissions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.user_impersonation").IsUuid(),
 				check.That(data.ResourceName).Key("oauth2_permissions.0.admin_consent_description").HasValue(
 					fmt.Sprintf("Allow the application to access %s on behalf of the signed-in user.",
 						fmt.Sprintf("acctestServicePrincipal-%d", data.RandomInteger))),
@@ -66,7 +71,9 @@ func TestAccServicePrincipalDataSource_byObjectId(t *testing.T) {
 				check.That(data.ResourceName).Key("object_id").Exists(),
 				check.That(data.ResourceName).Key("display_name").Exists(),
 				check.That(data.ResourceName).Key("app_roles.#").HasValue("0"),
+				check.That(data.ResourceName).Key("app_role_ids.%").HasValue("0"),
 				check.That(data.ResourceName).Key("oauth2_permissions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.user_impersonation").IsUuid(),
 				check.That(data.ResourceName).Key("oauth2_permissions.0.admin_consent_description").HasValue(
 					fmt.Sprintf("Allow the application to access %s on behalf of the signed-in user.",
 						fmt.Sprintf("acctestServicePrincipal-%d", data.RandomInteger))),
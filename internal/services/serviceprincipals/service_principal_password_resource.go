@@ -61,21 +61,14 @@ func servicePrincipalPasswordResourceCreate(ctx context.Context, d *schema.Resou
 	tf.LockByName(servicePrincipalResourceName, id.ObjectId)
 	defer tf.UnlockByName(servicePrincipalResourceName, id.ObjectId)
 
-	existingCreds, err := client.ListPasswordCredentials(ctx, id.ObjectId)
-	if err != nil {
-		return tf.ErrorDiagPathF(err, "service_principal_id", "Listing password credentials for service principal with ID %q", objectId)
-	}
-
-	newCreds, err := aadgraph.PasswordCredentialResultAdd(existingCreds, cred)
+	err = aadgraph.PasswordCredentialsUpdate(ctx, client, id.ObjectId, d.Timeout(schema.TimeoutCreate), func(existing graphrbac.PasswordCredentialListResult) (*[]graphrbac.PasswordCredential, error) {
+		return aadgraph.PasswordCredentialResultAdd(existing, cred)
+	})
 	if err != nil {
 		if _, ok := err.(*aadgraph.AlreadyExistsError); ok {
 			return tf.ImportAsExistsDiag("azuread_service_principal_password", id.String())
 		}
-		return tf.ErrorDiagF(err, "Adding service principal password")
-	}
-
-	if _, err = client.UpdatePasswordCredentials(ctx, objectId, graphrbac.PasswordCredentialsUpdateParameters{Value: newCreds}); err != nil {
-		return tf.ErrorDiagF(err, "Creating password credentials %q for service principal with object ID %q", id.KeyId, id.ObjectId)
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Creating password credentials %q for service principal with object ID %q", id.KeyId, id.ObjectId)
 	}
 
 	d.SetId(id.String())
@@ -168,18 +161,11 @@ func servicePrincipalPasswordResourceDelete(ctx context.Context, d *schema.Resou
 		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving service principal with object ID %q", id.ObjectId)
 	}
 
-	existing, err := client.ListPasswordCredentials(ctx, id.ObjectId)
-	if err != nil {
-		return tf.ErrorDiagPathF(err, "service_principal_id", "Listing password credentials for service principal with object ID %q", id.ObjectId)
-	}
-
-	newCreds, err := aadgraph.PasswordCredentialResultRemoveByKeyId(existing, id.KeyId)
+	err = aadgraph.PasswordCredentialsUpdate(ctx, client, id.ObjectId, d.Timeout(schema.TimeoutDelete), func(existing graphrbac.PasswordCredentialListResult) (*[]graphrbac.PasswordCredential, error) {
+		return aadgraph.PasswordCredentialResultRemoveByKeyId(existing, id.KeyId)
+	})
 	if err != nil {
-		return tf.ErrorDiagF(err, "Removing password credential %q from service principal with object ID %q", id.KeyId, id.ObjectId)
-	}
-
-	if _, err = client.UpdatePasswordCredentials(ctx, id.ObjectId, graphrbac.PasswordCredentialsUpdateParameters{Value: newCreds}); err != nil {
-		return tf.ErrorDiagF(err, "Removing password credential %q from service principal with object ID %q", id.KeyId, id.ObjectId)
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Removing password credential %q from service principal with object ID %q", id.KeyId, id.ObjectId)
 	}
 
 	return nil
@@ -5,15 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
@@ -21,6 +25,19 @@ import (
 
 const servicePrincipalResourceName = "azuread_service_principal"
 
+const (
+	servicePrincipalSingleSignOnModeNotSupported = "notSupported"
+	servicePrincipalSingleSignOnModeOidc         = "oidc"
+	servicePrincipalSingleSignOnModePassword     = "password"
+	servicePrincipalSingleSignOnModeSaml         = "saml"
+)
+
+// behaviours when the resource is destroyed
+const (
+	servicePrincipalDestroyBehaviorDelete  = "Delete"
+	servicePrincipalDestroyBehaviorDisable = "Disable"
+)
+
 func servicePrincipalResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: servicePrincipalResourceCreate,
@@ -28,12 +45,9 @@ func servicePrincipalResource() *schema.Resource {
 		UpdateContext: servicePrincipalResourceUpdate,
 		DeleteContext: servicePrincipalResourceDelete,
 
-		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
-			if _, err := uuid.ParseUUID(id); err != nil {
-				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
-			}
-			return nil
-		}),
+		CustomizeDiff: servicePrincipalResourceCustomizeDiff,
+
+		Importer: tf.ResolveResourceIDPriorToImport(servicePrincipalResourceImportResolve),
 
 		Schema: map[string]*schema.Schema{
 			"application_id": {
@@ -43,25 +57,141 @@ func servicePrincipalResource() *schema.Resource {
 				ValidateDiagFunc: validate.UUID,
 			},
 
+			"account_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"additional_properties": aadgraph.AdditionalPropertiesSchema(),
+
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"alternative_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+			},
+
 			"app_role_assignment_required": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
 
+			"destroy_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  servicePrincipalDestroyBehaviorDelete,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicePrincipalDestroyBehaviorDelete,
+					servicePrincipalDestroyBehaviorDisable,
+				}, false),
+			},
+
 			"display_name": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"homepage_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.URLIsHTTPOrHTTPS,
+			},
+
+			"login_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validate.URLIsHTTPOrHTTPS,
+			},
+
+			"notes": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"notification_email_addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.StringIsEmailAddress,
+				},
+			},
+
 			"object_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"claims_mapping_policy_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"home_realm_discovery_policy_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"token_lifetime_policy_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"app_roles": schemaAppRolesComputed(),
 
 			"oauth2_permissions": schemaOauth2PermissionsComputed(),
 
+			"preferred_single_sign_on_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicePrincipalSingleSignOnModeNotSupported,
+					servicePrincipalSingleSignOnModeOidc,
+					servicePrincipalSingleSignOnModePassword,
+					servicePrincipalSingleSignOnModeSaml,
+				}, false),
+			},
+
+			"saml_single_sign_on": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// the preferred certificate is managed by `azuread_service_principal_token_signing_certificate`
+						"preferred_token_signing_key_thumbprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"relay_state": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -79,11 +209,38 @@ func servicePrincipalResourceCreate(ctx context.Context, d *schema.ResourceData,
 
 	applicationId := d.Get("application_id").(string)
 
+	if d.Get("adopt_existing").(bool) {
+		existingId, err := servicePrincipalFindByApplicationId(ctx, client, applicationId)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "application_id", "Could not check for an existing service principal")
+		}
+		if existingId != "" {
+			return servicePrincipalResourceAdopt(ctx, d, meta, existingId)
+		}
+		log.Printf("[DEBUG] No existing service principal found for application ID %q - creating a new service principal", applicationId)
+	}
+
+	// applications instantiated from a template are created together with their service principal, which is adopted
+	// rather than created. This only applies to applications instantiated by this provider in the current run, so that
+	// other existing service principals are never adopted without `adopt_existing`.
+	if templateObjectId, ok := meta.(*clients.Client).TemplateServicePrincipal(applicationId); ok {
+		log.Printf("[DEBUG] Adopting service principal with object ID %q, created from application template", templateObjectId)
+
+		// the service principal is created using Microsoft Graph, so we must wait for it to become available in Azure
+		// Active Directory Graph
+		if _, err := aadgraph.WaitForCreationReplication(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+			return client.Get(ctx, templateObjectId)
+		}); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for service principal with object ID: %q", templateObjectId)
+		}
+
+		d.SetId(templateObjectId)
+		return servicePrincipalResourceUpdate(ctx, d, meta)
+	}
+
 	properties := graphrbac.ServicePrincipalCreateParameters{
-		AppID: utils.String(applicationId),
-		// there's no way of retrieving this, and there's no way of changing it
-		// given there's no way to change it - we'll just default this to true
-		AccountEnabled: utils.Bool(true),
+		AppID:          utils.String(applicationId),
+		AccountEnabled: utils.Bool(d.Get("account_enabled").(bool)),
 	}
 
 	if v, ok := d.GetOk("app_role_assignment_required"); ok {
@@ -110,6 +267,124 @@ func servicePrincipalResourceCreate(ctx context.Context, d *schema.ResourceData,
 		return tf.ErrorDiagF(err, "Waiting for service principal with object ID: %q", *sp.ObjectID)
 	}
 
+	// these properties are not modelled by the SDK, so they are set after the service principal has been created
+	if extendedProperties := expandServicePrincipalExtendedProperties(d, false); len(extendedProperties) > 0 {
+		if _, err := aadgraph.ServicePrincipalPatchProperties(ctx, client, *sp.ObjectID, extendedProperties); err != nil {
+			return tf.ErrorDiagF(err, "Could not set properties for service principal with object ID: %q", *sp.ObjectID)
+		}
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		additionalProperties, err := aadgraph.ExpandAdditionalProperties(v.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Parsing additional properties")
+		}
+		if _, err := aadgraph.ServicePrincipalPatchProperties(ctx, client, *sp.ObjectID, additionalProperties); err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Could not set additional properties")
+		}
+	}
+
+	return servicePrincipalResourceRead(ctx, d, meta)
+}
+
+// servicePrincipalResourceCustomizeDiff checks at plan time for configurations which would otherwise fail during apply
+func servicePrincipalResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := servicePrincipalValidateSingleSignOn(diff); err != nil {
+		return err
+	}
+
+	return servicePrincipalValidateExisting(ctx, diff, meta)
+}
+
+// servicePrincipalValidateSingleSignOn checks that SAML single sign-on settings are only configured for service
+// principals which use SAML single sign-on
+func servicePrincipalValidateSingleSignOn(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("preferred_single_sign_on_mode") || !diff.NewValueKnown("saml_single_sign_on") {
+		return nil
+	}
+	if !diff.HasChange("preferred_single_sign_on_mode") && !diff.HasChange("saml_single_sign_on") {
+		return nil
+	}
+
+	mode := diff.Get("preferred_single_sign_on_mode").(string)
+	if mode == "" || mode == servicePrincipalSingleSignOnModeSaml {
+		return nil
+	}
+
+	if diff.Get("saml_single_sign_on.0.relay_state").(string) != "" {
+		return fmt.Errorf("the `saml_single_sign_on` block can only be configured when `preferred_single_sign_on_mode` is %q, got %q", servicePrincipalSingleSignOnModeSaml, mode)
+	}
+
+	return nil
+}
+
+// servicePrincipalValidateExisting checks that no service principal already exists for the configured application when
+// one is to be created, since an application can only have one service principal in a tenant. The application ID is
+// not otherwise resolved, as service principals can be created for multi-tenant applications from other tenants.
+func servicePrincipalValidateExisting(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("application_id") || (diff.Id() != "" && !diff.HasChange("application_id")) {
+		return nil
+	}
+	if diff.Get("adopt_existing").(bool) {
+		return nil
+	}
+
+	applicationId := diff.Get("application_id").(string)
+	existingId, err := servicePrincipalFindByApplicationId(ctx, meta.(*clients.Client).ServicePrincipals.AadClient, applicationId)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to check for an existing service principal for application ID %q: %v", applicationId, err)
+		return nil
+	}
+
+	if existingId != "" {
+		return fmt.Errorf("a service principal with object ID %q already exists for application ID %q - to be managed via Terraform it needs to be imported, or `adopt_existing` must be set", existingId, applicationId)
+	}
+
+	return nil
+}
+
+// servicePrincipalResourceAdopt takes over an existing service principal for the configured application and reconciles
+// its properties with the configuration
+func servicePrincipalResourceAdopt(ctx context.Context, d *schema.ResourceData, meta interface{}, objectId string) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.AadClient
+
+	log.Printf("[DEBUG] Adopting existing service principal with object ID %q", objectId)
+
+	properties := graphrbac.ServicePrincipalUpdateParameters{
+		AccountEnabled:            utils.Bool(d.Get("account_enabled").(bool)),
+		AppRoleAssignmentRequired: utils.Bool(d.Get("app_role_assignment_required").(bool)),
+		Tags:                      tf.ExpandStringSlicePtr(d.Get("tags").(*schema.Set).List()),
+	}
+
+	if _, err := client.Update(ctx, objectId, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating existing service principal with object ID: %q", objectId)
+	}
+
+	extendedProperties := expandServicePrincipalExtendedProperties(d, false)
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		additionalProperties, err := aadgraph.ExpandAdditionalProperties(v.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Parsing additional properties")
+		}
+		aadgraph.MergeAdditionalProperties(extendedProperties, additionalProperties)
+	}
+
+	// properties which are not configured are cleared, with the exception of those which are also computed
+	aadgraph.MergeAdditionalProperties(extendedProperties, map[string]interface{}{
+		"alternativeNames":           []string{},
+		"loginUrl":                   nil,
+		"notes":                      nil,
+		"notificationEmailAddresses": []string{},
+		"preferredSingleSignOnMode":  nil,
+	})
+
+	if _, err := aadgraph.ServicePrincipalPatchProperties(ctx, client, objectId, extendedProperties); err != nil {
+		return tf.ErrorDiagF(err, "Updating properties for existing service principal with object ID: %q", objectId)
+	}
+
+	d.SetId(objectId)
+
 	return servicePrincipalResourceRead(ctx, d, meta)
 }
 
@@ -118,6 +393,10 @@ func servicePrincipalResourceUpdate(ctx context.Context, d *schema.ResourceData,
 
 	var properties graphrbac.ServicePrincipalUpdateParameters
 
+	if d.HasChange("account_enabled") {
+		properties.AccountEnabled = utils.Bool(d.Get("account_enabled").(bool))
+	}
+
 	if d.HasChange("app_role_assignment_required") {
 		properties.AppRoleAssignmentRequired = utils.Bool(d.Get("app_role_assignment_required").(bool))
 	}
@@ -135,6 +414,23 @@ func servicePrincipalResourceUpdate(ctx context.Context, d *schema.ResourceData,
 		return tf.ErrorDiagF(err, "Updating service principal with object ID: %q", d.Id())
 	}
 
+	if extendedProperties := expandServicePrincipalExtendedProperties(d, true); len(extendedProperties) > 0 {
+		if _, err := aadgraph.ServicePrincipalPatchProperties(ctx, client, d.Id(), extendedProperties); err != nil {
+			return tf.ErrorDiagF(err, "Updating properties for service principal with object ID: %q", d.Id())
+		}
+	}
+
+	if d.HasChange("additional_properties") {
+		oldProps, newProps := d.GetChange("additional_properties")
+		additionalProperties, err := aadgraph.ExpandAdditionalPropertiesChange(oldProps.(string), newProps.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Parsing additional properties")
+		}
+		if _, err := aadgraph.ServicePrincipalPatchProperties(ctx, client, d.Id(), additionalProperties); err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Could not set additional properties")
+		}
+	}
+
 	// Wait for replication delay after updating
 	_, err := aadgraph.WaitForCreationReplication(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
 		return client.Get(ctx, d.Id())
@@ -162,6 +458,19 @@ func servicePrincipalResourceRead(ctx context.Context, d *schema.ResourceData, m
 		return tf.ErrorDiagF(err, "retrieving service principal with object ID: %q", d.Id())
 	}
 
+	additionalProperties := ""
+	if v := d.Get("additional_properties").(string); v != "" {
+		properties, err := aadgraph.ServicePrincipalObjectProperties(ctx, client, d.Id())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Retrieving additional properties for service principal with object ID %q", d.Id())
+		}
+		if additionalProperties, err = aadgraph.FlattenAdditionalProperties(properties, v); err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Flattening additional properties for service principal with object ID %q", d.Id())
+		}
+	}
+
+	tf.Set(d, "additional_properties", additionalProperties)
+	tf.Set(d, "adopt_existing", d.Get("adopt_existing").(bool))
 	tf.Set(d, "app_role_assignment_required", sp.AppRoleAssignmentRequired)
 	tf.Set(d, "app_roles", aadgraph.FlattenAppRoles(sp.AppRoles))
 	tf.Set(d, "application_id", sp.AppID)
@@ -170,12 +479,43 @@ func servicePrincipalResourceRead(ctx context.Context, d *schema.ResourceData, m
 	tf.Set(d, "object_id", sp.ObjectID)
 	tf.Set(d, "tags", sp.Tags)
 
-	return nil
+	destroyBehavior := d.Get("destroy_behavior").(string)
+	if destroyBehavior == "" {
+		destroyBehavior = servicePrincipalDestroyBehaviorDelete
+	}
+	tf.Set(d, "destroy_behavior", destroyBehavior)
+
+	for k, v := range flattenServicePrincipalExtendedProperties(sp) {
+		// the block is only present when configured, or when a relay state has been set outside of Terraform
+		if k == "saml_single_sign_on" {
+			if saml := v.([]interface{}); len(saml) > 0 && saml[0].(map[string]interface{})["relay_state"] == "" && len(d.Get(k).([]interface{})) == 0 {
+				v = make([]interface{}, 0)
+			}
+		}
+		tf.Set(d, k, v)
+	}
+
+	return servicePrincipalReadAssignedPolicies(ctx, d, meta)
 }
 
 func servicePrincipalResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).ServicePrincipals.AadClient
 
+	if d.Get("destroy_behavior").(string) == servicePrincipalDestroyBehaviorDisable {
+		log.Printf("[INFO] Disabling service principal with object ID %q instead of deleting it", d.Id())
+		properties := graphrbac.ServicePrincipalUpdateParameters{
+			AccountEnabled: utils.Bool(false),
+		}
+		if resp, err := client.Update(ctx, d.Id(), properties); err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return tf.ErrorDiagF(err, "Disabling service principal with object ID: %q", d.Id())
+			}
+		}
+		return tf.DestroyBehaviorDiag("azuread_service_principal", d.Id(), servicePrincipalDestroyBehaviorDisable, "disabled instead of being deleted")
+	}
+
+	log.Printf("[INFO] Deleting service principal with object ID %q", d.Id())
+
 	applicationId := d.Id()
 	app, err := client.Delete(ctx, applicationId)
 	if err != nil {
@@ -186,3 +526,139 @@ func servicePrincipalResourceDelete(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+// servicePrincipalResourceImportResolve resolves the ID specified for an import, which can be either the object ID of
+// the service principal or the application ID of its associated application, to the object ID
+func servicePrincipalResourceImportResolve(ctx context.Context, id string, meta interface{}) (string, error) {
+	if _, err := uuid.ParseUUID(id); err != nil {
+		return "", fmt.Errorf("specified ID (%q) is not a valid object ID or application ID: %s", id, err)
+	}
+
+	client := meta.(*clients.Client).ServicePrincipals.AadClient
+
+	sp, err := client.Get(ctx, id)
+	if err == nil {
+		return id, nil
+	}
+	if !utils.ResponseWasNotFound(sp.Response) {
+		return "", fmt.Errorf("retrieving service principal with object ID %q: %+v", id, err)
+	}
+
+	// not an object ID, so look for a service principal with this application ID
+	objectId, err := servicePrincipalFindByApplicationId(ctx, client, id)
+	if err != nil {
+		return "", err
+	}
+	if objectId != "" {
+		return objectId, nil
+	}
+
+	return "", fmt.Errorf("no service principal found with object ID or application ID %q", id)
+}
+
+// servicePrincipalReadAssignedPolicies sets the IDs of policies assigned to the service principal. Policy assignments
+// are only available from Microsoft Graph, so these are left unchanged when Microsoft Graph is not available in the
+// configured environment, or when the caller does not have permission to read policies.
+func servicePrincipalReadAssignedPolicies(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.PoliciesClient
+
+	for _, v := range []struct {
+		attr       string
+		policyType msgraph.PolicyType
+	}{
+		{"claims_mapping_policy_ids", msgraph.PolicyTypeClaimsMapping},
+		{"home_realm_discovery_policy_ids", msgraph.PolicyTypeHomeRealmDiscovery},
+		{"token_lifetime_policy_ids", msgraph.PolicyTypeTokenLifetime},
+	} {
+		result, err := client.ListAssigned(ctx, msgraph.PolicyObjectTypeServicePrincipal, d.Id(), v.policyType)
+		if err != nil {
+			if err == msgraph.ErrNotAvailable || utils.ResponseWasStatusCode(result.Response, http.StatusForbidden) || utils.ResponseWasNotFound(result.Response) {
+				log.Printf("[DEBUG] Unable to list %s for service principal with object ID %q: %v", v.policyType, d.Id(), err)
+				return nil
+			}
+			return tf.ErrorDiagPathF(err, v.attr, "Listing %s for service principal with object ID %q", v.policyType, d.Id())
+		}
+
+		tf.Set(d, v.attr, msgraph.PolicyIDs(result))
+	}
+
+	return nil
+}
+
+// servicePrincipalFindByApplicationId returns the object ID of the service principal for the specified application. An
+// empty string is returned if there is no such service principal.
+func servicePrincipalFindByApplicationId(ctx context.Context, client *graphrbac.ServicePrincipalsClient, applicationId string) (string, error) {
+	filter := fmt.Sprintf("appId eq '%s'", aadgraph.ODataString(applicationId))
+	result, err := client.ListComplete(ctx, filter)
+	if err != nil {
+		return "", fmt.Errorf("listing service principals for filter %q: %+v", filter, err)
+	}
+
+	for result.NotDone() {
+		sp := result.Value()
+		if sp.ObjectID != nil && sp.AppID != nil && strings.EqualFold(*sp.AppID, applicationId) {
+			return *sp.ObjectID, nil
+		}
+
+		if err := result.NextWithContext(ctx); err != nil {
+			return "", fmt.Errorf("listing service principals for filter %q: %+v", filter, err)
+		}
+	}
+
+	return "", nil
+}
+
+// expandServicePrincipalExtendedProperties returns the enterprise application properties for a service principal which
+// are not modelled by the SDK. When onlyChanged is true, only properties which have changed are returned.
+func expandServicePrincipalExtendedProperties(d *schema.ResourceData, onlyChanged bool) map[string]interface{} {
+	properties := make(map[string]interface{})
+
+	// the API expects null rather than an empty string when a property is removed
+	nullableString := func(key string) interface{} {
+		if v := d.Get(key).(string); v != "" {
+			return v
+		}
+		return nil
+	}
+
+	include := func(key string) bool {
+		if onlyChanged {
+			return d.HasChange(key)
+		}
+		_, ok := d.GetOk(key)
+		return ok
+	}
+
+	if include("alternative_names") {
+		properties["alternativeNames"] = *tf.ExpandStringSlicePtr(d.Get("alternative_names").(*schema.Set).List())
+	}
+
+	if include("homepage_url") {
+		properties["homepage"] = nullableString("homepage_url")
+	}
+
+	if include("login_url") {
+		properties["loginUrl"] = nullableString("login_url")
+	}
+
+	if include("notes") {
+		properties["notes"] = nullableString("notes")
+	}
+
+	if include("notification_email_addresses") {
+		properties["notificationEmailAddresses"] = *tf.ExpandStringSlicePtr(d.Get("notification_email_addresses").(*schema.Set).List())
+	}
+
+	if include("preferred_single_sign_on_mode") {
+		properties["preferredSingleSignOnMode"] = nullableString("preferred_single_sign_on_mode")
+	}
+
+	// the relay state is cleared when the block is removed
+	if include("saml_single_sign_on") {
+		properties["samlSingleSignOnSettings"] = map[string]interface{}{
+			"relayState": nullableString("saml_single_sign_on.0.relay_state"),
+		}
+	}
+
+	return properties
+}
@@ -3,6 +3,7 @@ package serviceprincipals_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -11,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
 	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
 )
 
@@ -31,6 +33,21 @@ func TestAccServicePrincipal_basic(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipal_importByApplicationId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepUsingAttribute("application_id"),
+	})
+}
+
 func TestAccServicePrincipal_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
 	r := ServicePrincipalResource{}
@@ -40,6 +57,13 @@ func TestAccServicePrincipal_complete(t *testing.T) {
 			Config: r.complete(data),
 			Check: resource.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("account_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("alternative_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("homepage_url").HasValue(fmt.Sprintf("https://test-%d.internal", data.RandomInteger)),
+				check.That(data.ResourceName).Key("login_url").HasValue(fmt.Sprintf("https://test-%d.internal/login", data.RandomInteger)),
+				check.That(data.ResourceName).Key("notes").HasValue("testing 1-2-3"),
+				check.That(data.ResourceName).Key("notification_email_addresses.#").HasValue("2"),
+				check.That(data.ResourceName).Key("preferred_single_sign_on_mode").HasValue("saml"),
 			),
 		},
 		data.ImportStep(),
@@ -75,6 +99,139 @@ func TestAccServicePrincipal_update(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipal_additionalProperties(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.additionalProperties(data, "error"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("additional_properties").HasValue(`{"errorUrl":"https://example.com/error"}`),
+			),
+		},
+		data.ImportStep("additional_properties"),
+		{
+			Config: r.additionalProperties(data, "error-updated"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("additional_properties").HasValue(`{"errorUrl":"https://example.com/error-updated"}`),
+			),
+		},
+		data.ImportStep("additional_properties"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				r.additionalPropertyCleared(data.ResourceName, "errorUrl"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipal_samlSingleSignOn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.samlSingleSignOn(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("saml_single_sign_on.#").HasValue("1"),
+				check.That(data.ResourceName).Key("saml_single_sign_on.0.relay_state").HasValue("/samlHome"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.samlSingleSignOnRemoved(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("saml_single_sign_on.#").HasValue("0"),
+				r.relayStateCleared(data.ResourceName),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipal_samlSingleSignOnRequiresSamlMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.samlSingleSignOnWrongMode(data),
+			ExpectError: regexp.MustCompile("can only be configured when `preferred_single_sign_on_mode` is"),
+		},
+	})
+}
+
+func TestAccServicePrincipal_adoptExisting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.adoptExisting(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				resource.TestCheckResourceAttrPair("azuread_service_principal.adopted", "object_id", data.ResourceName, "object_id"),
+			),
+		},
+	})
+}
+
+func TestAccServicePrincipal_existingRequiresAdopt(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.existingNotAdopted(data),
+			ExpectError: regexp.MustCompile("already exists for application ID"),
+		},
+	})
+}
+
+func TestAccServicePrincipal_destroyBehaviorDisable(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	// deleting the application also deletes its service principal, so the outcome of destroying the service principal
+	// is checked whilst the application still exists
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.destroyBehaviorDisable(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("destroy_behavior").HasValue("Disable"),
+			),
+		},
+		data.ImportStep("destroy_behavior"),
+		{
+			Config: r.destroyBehaviorDisableRemoved(data),
+			Check: resource.ComposeTestCheckFunc(
+				r.disabledForApplication("azuread_application.test"),
+			),
+		},
+	})
+}
+
 func (r ServicePrincipalResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	resp, err := clients.ServicePrincipals.AadClient.Get(ctx, state.ID)
 
@@ -88,6 +245,79 @@ func (r ServicePrincipalResource) Exists(ctx context.Context, clients *clients.C
 	return utils.Bool(resp.ObjectID != nil && *resp.ObjectID == state.ID), nil
 }
 
+func (ServicePrincipalResource) additionalPropertyCleared(resourceName, property string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureADProvider.Meta().(*clients.Client)
+		ctx := client.StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		properties, err := aadgraph.ServicePrincipalObjectProperties(ctx, client.ServicePrincipals.AadClient, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve Service Principal with object ID %q: %+v", rs.Primary.ID, err)
+		}
+		if v := properties[property]; v != nil {
+			return fmt.Errorf("expected property %q of Service Principal with object ID %q to be cleared, got %v", property, rs.Primary.ID, v)
+		}
+
+		return nil
+	}
+}
+
+func (ServicePrincipalResource) relayStateCleared(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureADProvider.Meta().(*clients.Client)
+		ctx := client.StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		properties, err := aadgraph.ServicePrincipalObjectProperties(ctx, client.ServicePrincipals.AadClient, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve Service Principal with object ID %q: %+v", rs.Primary.ID, err)
+		}
+		if settings, ok := properties["samlSingleSignOnSettings"].(map[string]interface{}); ok && settings["relayState"] != nil {
+			return fmt.Errorf("expected relay state of Service Principal with object ID %q to be cleared, got %v", rs.Primary.ID, settings["relayState"])
+		}
+
+		return nil
+	}
+}
+
+// disabledForApplication checks that the service principal for an application still exists and is disabled
+func (ServicePrincipalResource) disabledForApplication(applicationResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureADProvider.Meta().(*clients.Client)
+		ctx := client.StopContext
+
+		rs, ok := s.RootModule().Resources[applicationResourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", applicationResourceName)
+		}
+		applicationId := rs.Primary.Attributes["application_id"]
+
+		filter := fmt.Sprintf("appId eq '%s'", aadgraph.ODataString(applicationId))
+		result, err := client.ServicePrincipals.AadClient.ListComplete(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("listing Service Principals for filter %q: %+v", filter, err)
+		}
+		if !result.NotDone() {
+			return fmt.Errorf("expected Service Principal for application ID %q to still exist after being destroyed", applicationId)
+		}
+
+		if sp := result.Value(); sp.AccountEnabled == nil || *sp.AccountEnabled {
+			return fmt.Errorf("expected Service Principal for application ID %q to be disabled after being destroyed", applicationId)
+		}
+
+		return nil
+	}
+}
+
 func (ServicePrincipalResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
@@ -100,6 +330,27 @@ resource "azuread_service_principal" "test" {
 `, data.RandomInteger)
 }
 
+func (ServicePrincipalResource) destroyBehaviorDisable(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id   = azuread_application.test.application_id
+  destroy_behavior = "Disable"
+}
+`, data.RandomInteger)
+}
+
+func (ServicePrincipalResource) destroyBehaviorDisableRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestServicePrincipal-%[1]d"
+}
+`, data.RandomInteger)
+}
+
 func (ServicePrincipalResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
@@ -107,10 +358,101 @@ resource "azuread_application" "test" {
 }
 
 resource "azuread_service_principal" "test" {
-  application_id               = azuread_application.test.application_id
-  app_role_assignment_required = true
+  application_id                = azuread_application.test.application_id
+  account_enabled               = false
+  alternative_names             = ["foo", "bar"]
+  app_role_assignment_required  = true
+  homepage_url                  = "https://test-%[1]d.internal"
+  login_url                     = "https://test-%[1]d.internal/login"
+  notes                         = "testing 1-2-3"
+  notification_email_addresses  = ["alerts.queue@contoso.com", "cto@contoso.com"]
+  preferred_single_sign_on_mode = "saml"
 
   tags = ["test", "multiple", "CapitalS"]
 }
 `, data.RandomInteger)
 }
+
+func (ServicePrincipalResource) additionalProperties(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+
+  additional_properties = jsonencode({
+    errorUrl = "https://example.com/%[2]s"
+  })
+}
+`, data.RandomInteger, value)
+}
+
+func (ServicePrincipalResource) samlSingleSignOnWrongMode(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id                = azuread_application.test.application_id
+  preferred_single_sign_on_mode = "oidc"
+
+  saml_single_sign_on {
+    relay_state = "/samlHome"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (ServicePrincipalResource) samlSingleSignOn(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id                = azuread_application.test.application_id
+  preferred_single_sign_on_mode = "saml"
+
+  saml_single_sign_on {
+    relay_state = "/samlHome"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (ServicePrincipalResource) samlSingleSignOnRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id                = azuread_application.test.application_id
+  preferred_single_sign_on_mode = "saml"
+}
+`, data.RandomInteger)
+}
+
+func (r ServicePrincipalResource) adoptExisting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal" "adopted" {
+  application_id = azuread_application.test.application_id
+  adopt_existing = true
+}
+`, r.basic(data))
+}
+
+func (r ServicePrincipalResource) existingNotAdopted(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal" "duplicate" {
+  application_id = azuread_application.test.application_id
+}
+`, r.basic(data))
+}
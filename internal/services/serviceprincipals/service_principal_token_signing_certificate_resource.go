@@ -0,0 +1,350 @@
+package serviceprincipals
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/msgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/serviceprincipals/parse"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/tf"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
+)
+
+func servicePrincipalTokenSigningCertificateResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: servicePrincipalTokenSigningCertificateResourceCreate,
+		ReadContext:   servicePrincipalTokenSigningCertificateResourceRead,
+		UpdateContext: servicePrincipalTokenSigningCertificateResourceUpdate,
+		DeleteContext: servicePrincipalTokenSigningCertificateResourceDelete,
+
+		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.TokenSigningCertificateID(id)
+			return err
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"service_principal_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validate.UUID,
+			},
+
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^CN=.+"), "must begin with `CN=`"),
+			},
+
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"federation_metadata_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"start_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func servicePrincipalTokenSigningCertificateResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.AadClient
+	msClient := meta.(*clients.Client).ServicePrincipals.MsGraphClient
+
+	objectId := d.Get("service_principal_id").(string)
+
+	params := msgraph.TokenSigningCertificateParameters{}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		params.DisplayName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("end_date"); ok {
+		endDate, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "end_date", "Unable to parse the provided end date %q", v)
+		}
+		params.EndDateTime = &date.Time{Time: endDate}
+	}
+
+	tf.LockByName(servicePrincipalResourceName, objectId)
+	defer tf.UnlockByName(servicePrincipalResourceName, objectId)
+
+	cert, err := msClient.AddTokenSigningCertificate(ctx, objectId, params)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Generating token signing certificate for service principal with object ID %q", objectId)
+	}
+
+	if cert.CustomKeyIdentifier == nil || cert.Thumbprint == nil || cert.Key == nil {
+		return tf.ErrorDiagF(errors.New("custom key identifier, thumbprint or key was nil"), "Bad API response")
+	}
+
+	// the generated credentials are identified by their custom key identifier, since the returned key ID does not
+	// necessarily refer to the `Sign` key credential
+	credential, err := aadgraph.WaitForSigningKeyCredentialReplication(ctx, *cert.CustomKeyIdentifier, d.Timeout(schema.TimeoutCreate), func() (graphrbac.KeyCredentialListResult, error) {
+		return client.ListKeyCredentials(ctx, objectId)
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Waiting for token signing certificate replication for service principal with object ID %q", objectId)
+	}
+	if credential == nil || credential.KeyID == nil {
+		return tf.ErrorDiagF(errors.New("key ID returned for signing key credential was nil"), "Bad API response")
+	}
+
+	id := parse.NewCredentialID(objectId, "tokenSigningCertificate", *credential.KeyID)
+	d.SetId(id.String())
+
+	// the certificate is only returned when it is generated
+	der, err := base64.StdEncoding.DecodeString(*cert.Key)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Decoding token signing certificate for service principal with object ID %q", objectId)
+	}
+	tf.Set(d, "value", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+	tf.Set(d, "display_name", cert.DisplayName)
+
+	if d.Get("active").(bool) {
+		properties := map[string]interface{}{
+			"preferredTokenSigningKeyThumbprint": strings.ToUpper(*cert.Thumbprint),
+		}
+		if _, err := aadgraph.ServicePrincipalPatchProperties(ctx, client, objectId, properties); err != nil {
+			return tf.ErrorDiagPathF(err, "active", "Activating token signing certificate for service principal with object ID %q", objectId)
+		}
+	}
+
+	return servicePrincipalTokenSigningCertificateResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalTokenSigningCertificateResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.AadClient
+
+	id, err := parse.TokenSigningCertificateID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing token signing certificate with ID %q", d.Id())
+	}
+
+	if d.HasChange("active") {
+		tf.LockByName(servicePrincipalResourceName, id.ObjectId)
+		defer tf.UnlockByName(servicePrincipalResourceName, id.ObjectId)
+
+		if err := servicePrincipalTokenSigningCertificateActivate(ctx, client, id.ObjectId, d.Get("thumbprint").(string), d.Get("active").(bool)); err != nil {
+			return tf.ErrorDiagPathF(err, "active", "Updating preferred token signing certificate for service principal with object ID %q", id.ObjectId)
+		}
+	}
+
+	return servicePrincipalTokenSigningCertificateResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalTokenSigningCertificateResourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.AadClient
+	environment := meta.(*clients.Client).Environment
+	tenantId := meta.(*clients.Client).TenantID
+
+	id, err := parse.TokenSigningCertificateID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing token signing certificate with ID %q", d.Id())
+	}
+
+	// ensure the Service Principal Object exists
+	sp, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		// the parent Service Principal has been removed - skip it
+		if utils.ResponseWasNotFound(sp.Response) {
+			log.Printf("[DEBUG] Service Principal with Object ID %q was not found - removing from state!", id.ObjectId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving service principal with object ID %q", id.ObjectId)
+	}
+
+	credentials, err := client.ListKeyCredentials(ctx, id.ObjectId)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Listing key credentials for service principal with object ID %q", id.ObjectId)
+	}
+
+	credential := aadgraph.KeyCredentialResultFindByKeyId(credentials, id.KeyId)
+	if credential == nil || credential.Usage == nil || !strings.EqualFold(*credential.Usage, "Sign") {
+		log.Printf("[DEBUG] Token signing certificate %q (ID %q) was not found - removing from state!", id.KeyId, id.ObjectId)
+		d.SetId("")
+		return nil
+	}
+
+	// the custom key identifier of a token signing certificate is its SHA-1 thumbprint
+	thumbprint := ""
+	if credential.CustomKeyIdentifier != nil {
+		customKeyIdentifier, err := base64.StdEncoding.DecodeString(*credential.CustomKeyIdentifier)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "thumbprint", "Decoding custom key identifier for token signing certificate %q", id.KeyId)
+		}
+		thumbprint = strings.ToUpper(hex.EncodeToString(customKeyIdentifier))
+	}
+
+	preferredThumbprint := ""
+	if v, ok := sp.AdditionalProperties["preferredTokenSigningKeyThumbprint"].(string); ok {
+		preferredThumbprint = v
+	}
+
+	tf.Set(d, "service_principal_id", id.ObjectId)
+	tf.Set(d, "key_id", id.KeyId)
+	tf.Set(d, "thumbprint", thumbprint)
+	tf.Set(d, "active", thumbprint != "" && strings.EqualFold(thumbprint, preferredThumbprint))
+
+	startDate := ""
+	if v := credential.StartDate; v != nil {
+		startDate = v.Format(time.RFC3339)
+	}
+	tf.Set(d, "start_date", startDate)
+
+	endDate := ""
+	if v := credential.EndDate; v != nil {
+		endDate = v.Format(time.RFC3339)
+	}
+	tf.Set(d, "end_date", endDate)
+
+	federationMetadataUrl := ""
+	if sp.AppID != nil {
+		federationMetadataUrl = fmt.Sprintf("%s/%s/federationmetadata/2007-06/federationmetadata.xml?appid=%s", strings.TrimSuffix(environment.ActiveDirectoryEndpoint, "/"), tenantId, *sp.AppID)
+	}
+	tf.Set(d, "federation_metadata_url", federationMetadataUrl)
+
+	return nil
+}
+
+func servicePrincipalTokenSigningCertificateResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.AadClient
+
+	id, err := parse.TokenSigningCertificateID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing token signing certificate with ID %q", d.Id())
+	}
+
+	tf.LockByName(servicePrincipalResourceName, id.ObjectId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ObjectId)
+
+	// ensure the parent Service Principal exists
+	sp, err := client.Get(ctx, id.ObjectId)
+	if err != nil {
+		// the parent Service Principal has been removed - skip it
+		if utils.ResponseWasNotFound(sp.Response) {
+			log.Printf("[DEBUG] Service Principal with Object ID %q was not found - removing from state!", id.ObjectId)
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving service principal with object ID %q", id.ObjectId)
+	}
+
+	existingKeys, err := client.ListKeyCredentials(ctx, id.ObjectId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing key credentials for service principal with object ID %q", id.ObjectId)
+	}
+
+	credential := aadgraph.KeyCredentialResultFindByKeyId(existingKeys, id.KeyId)
+	if credential == nil || credential.CustomKeyIdentifier == nil {
+		log.Printf("[DEBUG] Token signing certificate %q (ID %q) was not found - removing from state!", id.KeyId, id.ObjectId)
+		return nil
+	}
+	customKeyIdentifier := *credential.CustomKeyIdentifier
+
+	if d.Get("active").(bool) {
+		if err := servicePrincipalTokenSigningCertificateActivate(ctx, client, id.ObjectId, d.Get("thumbprint").(string), false); err != nil {
+			return tf.ErrorDiagF(err, "Deactivating token signing certificate %q for service principal with object ID %q", id.KeyId, id.ObjectId)
+		}
+	}
+
+	// remove both the `Sign` and `Verify` key credentials for the certificate
+	err = aadgraph.KeyCredentialsUpdate(ctx, client, id.ObjectId, d.Timeout(schema.TimeoutDelete), func(existing graphrbac.KeyCredentialListResult) (*[]graphrbac.KeyCredential, error) {
+		return aadgraph.KeyCredentialResultRemoveByCustomKeyIdentifier(existing, customKeyIdentifier)
+	})
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Removing token signing certificate %q from service principal with object ID %q", id.KeyId, id.ObjectId)
+	}
+
+	// remove the password credential protecting the private key
+	err = aadgraph.PasswordCredentialsUpdate(ctx, client, id.ObjectId, d.Timeout(schema.TimeoutDelete), func(existing graphrbac.PasswordCredentialListResult) (*[]graphrbac.PasswordCredential, error) {
+		return aadgraph.PasswordCredentialResultRemoveByCustomKeyIdentifier(existing, customKeyIdentifier)
+	})
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Removing token signing certificate password %q from service principal with object ID %q", id.KeyId, id.ObjectId)
+	}
+
+	return nil
+}
+
+// servicePrincipalTokenSigningCertificateActivate sets the certificate having the specified thumbprint as the
+// preferred token signing certificate for the service principal. When active is false, the preference is only cleared
+// if this certificate is currently preferred.
+func servicePrincipalTokenSigningCertificateActivate(ctx context.Context, client *graphrbac.ServicePrincipalsClient, objectId, thumbprint string, active bool) error {
+	if thumbprint == "" {
+		return errors.New("thumbprint for token signing certificate is unknown")
+	}
+
+	var preferredThumbprint interface{}
+	if active {
+		preferredThumbprint = strings.ToUpper(thumbprint)
+	} else {
+		sp, err := client.Get(ctx, objectId)
+		if err != nil {
+			return fmt.Errorf("retrieving service principal: %+v", err)
+		}
+		if v, ok := sp.AdditionalProperties["preferredTokenSigningKeyThumbprint"].(string); !ok || !strings.EqualFold(v, thumbprint) {
+			return nil
+		}
+	}
+
+	properties := map[string]interface{}{
+		"preferredTokenSigningKeyThumbprint": preferredThumbprint,
+	}
+	if _, err := aadgraph.ServicePrincipalPatchProperties(ctx, client, objectId, properties); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package serviceprincipals_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/services/serviceprincipals/parse"
+	"github.com/terraform-providers/terraform-provider-azuread/internal/utils"
+)
+
+type ServicePrincipalTokenSigningCertificateResource struct{}
+
+func TestAccServicePrincipalTokenSigningCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_token_signing_certificate", "test")
+	r := ServicePrincipalTokenSigningCertificateResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").IsUuid(),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+				check.That(data.ResourceName).Key("value").Exists(),
+				check.That(data.ResourceName).Key("federation_metadata_url").Exists(),
+				check.That(data.ResourceName).Key("active").HasValue("false"),
+			),
+		},
+		data.ImportStep("display_name", "value"),
+	})
+}
+
+func TestAccServicePrincipalTokenSigningCertificate_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_token_signing_certificate", "test")
+	endDate := time.Now().AddDate(1, 0, 0).UTC().Format(time.RFC3339)
+	r := ServicePrincipalTokenSigningCertificateResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data, endDate),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("active").HasValue("true"),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("CN=acctestTokenSigning-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("end_date").HasValue(endDate),
+			),
+		},
+		data.ImportStep("display_name", "value"),
+	})
+}
+
+func TestAccServicePrincipalTokenSigningCertificate_rotate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_token_signing_certificate", "test")
+	r := ServicePrincipalTokenSigningCertificateResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.activated(data, "test"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("active").HasValue("true"),
+			),
+		},
+		{
+			Config: r.rotated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("active").HasValue("false"),
+				check.That("azuread_service_principal_token_signing_certificate.next").ExistsInAzure(r),
+				check.That("azuread_service_principal_token_signing_certificate.next").Key("active").HasValue("true"),
+			),
+		},
+		{
+			Config: r.activated(data, "next"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That("azuread_service_principal_token_signing_certificate.next").ExistsInAzure(r),
+				check.That("azuread_service_principal_token_signing_certificate.next").Key("active").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (r ServicePrincipalTokenSigningCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.TokenSigningCertificateID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Token Signing Certificate ID: %v", err)
+	}
+
+	resp, err := clients.ServicePrincipals.AadClient.Get(ctx, id.ObjectId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("Service Principal with object ID %q does not exist", id.ObjectId)
+		}
+		return nil, fmt.Errorf("failed to retrieve Service Principal with object ID %q: %+v", id.ObjectId, err)
+	}
+
+	credentials, err := clients.ServicePrincipals.AadClient.ListKeyCredentials(ctx, id.ObjectId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Key Credentials for Service Principal %q: %+v", id.ObjectId, err)
+	}
+
+	cred := aadgraph.KeyCredentialResultFindByKeyId(credentials, id.KeyId)
+	if cred != nil && cred.Usage != nil && strings.EqualFold(*cred.Usage, "Sign") {
+		return utils.Bool(true), nil
+	}
+
+	return nil, fmt.Errorf("Signing Key Credential %q was not found for Service Principal %q", id.KeyId, id.ObjectId)
+}
+
+func (ServicePrincipalTokenSigningCertificateResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id                = azuread_application.test.application_id
+  preferred_single_sign_on_mode = "saml"
+}
+`, data.RandomInteger)
+}
+
+func (r ServicePrincipalTokenSigningCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_token_signing_certificate" "test" {
+  service_principal_id = azuread_service_principal.test.id
+}
+`, r.template(data))
+}
+
+func (r ServicePrincipalTokenSigningCertificateResource) complete(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_token_signing_certificate" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  display_name         = "CN=acctestTokenSigning-%[2]d"
+  end_date             = "%[3]s"
+  active               = true
+}
+`, r.template(data), data.RandomInteger, endDate)
+}
+
+func (r ServicePrincipalTokenSigningCertificateResource) activated(data acceptance.TestData, name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_token_signing_certificate" "%[2]s" {
+  service_principal_id = azuread_service_principal.test.id
+  active               = true
+}
+`, r.template(data), name)
+}
+
+func (r ServicePrincipalTokenSigningCertificateResource) rotated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_token_signing_certificate" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  active               = false
+}
+
+resource "azuread_service_principal_token_signing_certificate" "next" {
+  service_principal_id = azuread_service_principal.test.id
+  active               = true
+}
+`, r.template(data))
+}
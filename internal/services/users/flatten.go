@@ -0,0 +1,46 @@
+package users
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+
+	"github.com/terraform-providers/terraform-provider-azuread/internal/helpers/aadgraph"
+)
+
+// flattenUserExtendedProperties returns the extended profile attributes for a user, keyed by attribute name
+func flattenUserExtendedProperties(user graphrbac.User) map[string]interface{} {
+	props := user.AdditionalProperties
+
+	stringProp := func(key string) string {
+		if v, ok := props[key].(string); ok {
+			return v
+		}
+		return ""
+	}
+
+	businessPhones := make([]interface{}, 0)
+	if v := stringProp("telephoneNumber"); v != "" {
+		businessPhones = append(businessPhones, v)
+	}
+
+	// showInAddressList is null unless it has been explicitly set, in which case the user is shown
+	showInAddressList := true
+	if v, ok := props["showInAddressList"].(bool); ok {
+		showInAddressList = v
+	}
+
+	return map[string]interface{}{
+		"user_type":                       string(user.UserType),
+		"employee_id":                     stringProp("employeeId"),
+		"employee_type":                   stringProp("employeeType"),
+		"other_mails":                     aadgraph.FlattenStringListProperty(props, "otherMails"),
+		"business_phones":                 businessPhones,
+		"fax_number":                      stringProp("facsimileTelephoneNumber"),
+		"office_location":                 stringProp("physicalDeliveryOfficeName"),
+		"preferred_language":              stringProp("preferredLanguage"),
+		"show_in_address_list":            showInAddressList,
+		"age_group":                       stringProp("ageGroup"),
+		"consent_provided_for_minor":      stringProp("consentProvidedForMinor"),
+		"creation_type":                   stringProp("creationType"),
+		"onpremises_extension_attributes": aadgraph.UserFlattenOnPremisesExtensionAttributes(props),
+	}
+}
@@ -32,6 +32,11 @@ func userData() *schema.Resource {
 				ConflictsWith:    []string{"user_principal_name"},
 			},
 
+			"object_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"user_principal_name": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -156,6 +161,93 @@ func userData() *schema.Resource {
 				Computed:    true,
 				Description: "The primary cellular telephone number for the user.",
 			},
+
+			"user_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user type in the directory. One of `Guest` or `Member`.",
+			},
+
+			"employee_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The employee identifier assigned to the user by the organisation.",
+			},
+
+			"employee_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Captures enterprise worker type, e.g. `Employee`, `Contractor`, `Consultant`, or `Vendor`.",
+			},
+
+			"other_mails": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Additional email addresses for the user.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"business_phones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The telephone numbers for the user.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"fax_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fax number of the user.",
+			},
+
+			"office_location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The office location in the user's place of business.",
+			},
+
+			"preferred_language": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user's preferred language, in ISO 639-1 format.",
+			},
+
+			"show_in_address_list": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not the Outlook global address list should include this user.",
+			},
+
+			"age_group": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The age group of the user, used for parental controls.",
+			},
+
+			"consent_provided_for_minor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether consent has been obtained for minors.",
+			},
+
+			"creation_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates whether the user account was created as a regular school or work account, an external account, a local account or via self-service sign-up.",
+			},
+
+			"onpremises_extension_attributes": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "A map of extension attributes for the user.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -276,5 +368,15 @@ func userDataRead(ctx context.Context, d *schema.ResourceData, meta interface{})
 	}
 	tf.Set(d, "mobile", mobile)
 
+	for k, v := range flattenUserExtendedProperties(user) {
+		tf.Set(d, k, v)
+	}
+
+	objectJson, err := aadgraph.UserObjectJSON(ctx, client, *user.ObjectID)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "object_json", "Retrieving JSON representation of user with object ID %q", *user.ObjectID)
+	}
+	tf.Set(d, "object_json", objectJson)
+
 	return nil
 }
@@ -74,6 +74,7 @@ func (UserDataSource) testCheckFunc(data acceptance.TestData) resource.TestCheck
 	return resource.ComposeTestCheckFunc(
 		check.That(data.ResourceName).Key("object_id").IsUuid(),
 		check.That(data.ResourceName).Key("immutable_id").Exists(),
+		check.That(data.ResourceName).Key("object_json").Exists(),
 		check.That(data.ResourceName).Key("user_principal_name").Exists(),
 		check.That(data.ResourceName).Key("account_enabled").Exists(),
 		check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestUser-%d-DisplayName", data.RandomInteger)),
@@ -92,6 +93,18 @@ func (UserDataSource) testCheckFunc(data acceptance.TestData) resource.TestCheck
 		check.That(data.ResourceName).Key("country").HasValue(fmt.Sprintf("acctestUser-%d-Country", data.RandomInteger)),
 		check.That(data.ResourceName).Key("postal_code").HasValue("111111"),
 		check.That(data.ResourceName).Key("mobile").HasValue("(555) 555-5555"),
+		check.That(data.ResourceName).Key("user_type").HasValue("Member"),
+		check.That(data.ResourceName).Key("employee_id").HasValue(data.RandomString),
+		check.That(data.ResourceName).Key("employee_type").HasValue("Contractor"),
+		check.That(data.ResourceName).Key("other_mails.#").HasValue("1"),
+		check.That(data.ResourceName).Key("business_phones.0").HasValue("(555) 555-1234"),
+		check.That(data.ResourceName).Key("fax_number").HasValue("(555) 555-4321"),
+		check.That(data.ResourceName).Key("office_location").HasValue(fmt.Sprintf("acctestUser-%d-PDON", data.RandomInteger)),
+		check.That(data.ResourceName).Key("preferred_language").HasValue("en-GB"),
+		check.That(data.ResourceName).Key("show_in_address_list").HasValue("false"),
+		check.That(data.ResourceName).Key("age_group").HasValue("Adult"),
+		check.That(data.ResourceName).Key("consent_provided_for_minor").HasValue("NotRequired"),
+		check.That(data.ResourceName).Key("onpremises_extension_attributes.extensionAttribute1").HasValue(fmt.Sprintf("acctestUser-%d-Ext1", data.RandomInteger)),
 	)
 }
 
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,6 +22,12 @@ import (
 	"github.com/terraform-providers/terraform-provider-azuread/internal/validate"
 )
 
+// behaviours when the resource is destroyed
+const (
+	userDestroyBehaviorDelete  = "Delete"
+	userDestroyBehaviorDisable = "Disable"
+)
+
 func userResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: userResourceCreate,
@@ -27,27 +35,35 @@ func userResource() *schema.Resource {
 		UpdateContext: userResourceUpdate,
 		DeleteContext: userResourceDelete,
 
-		Importer: tf.ValidateResourceIDPriorToImport(func(id string) error {
-			if _, err := uuid.ParseUUID(id); err != nil {
-				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
-			}
-			return nil
-		}),
+		CustomizeDiff: userResourceCustomizeDiff,
+
+		Importer: tf.ResolveResourceIDPriorToImport(userResourceImportResolve),
 
 		Schema: map[string]*schema.Schema{
 			"user_principal_name": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ForceNew:         true,
 				ValidateDiagFunc: validate.StringIsEmailAddress,
 			},
 
+			"retain_previous_user_principal_name": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to add the previous user principal name to `other_mails` when the user principal name is changed.",
+
+				// the retained user principal name would otherwise show as drift against a configured `other_mails`
+				ConflictsWith: []string{"other_mails"},
+			},
+
 			"display_name": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateDiagFunc: validate.NoEmptyStrings,
 			},
 
+			"additional_properties": aadgraph.AdditionalPropertiesSchema(),
+
 			"given_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -81,6 +97,31 @@ func userResource() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 256), //currently the max length for AAD passwords is 256
 			},
 
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to take over an existing user with the same user principal name instead of creating a new one.",
+			},
+
+			"adopt_existing_reset_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to reset the password of an existing user to the configured `password` when it is adopted.",
+			},
+
+			"destroy_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  userDestroyBehaviorDelete,
+				ValidateFunc: validation.StringInSlice([]string{
+					userDestroyBehaviorDelete,
+					userDestroyBehaviorDisable,
+				}, false),
+				Description: "What happens to the user when the resource is destroyed. One of `Delete` or `Disable`.",
+			},
+
 			"force_password_change": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -195,6 +236,125 @@ func userResource() *schema.Resource {
 				Computed:    true,
 				Description: "The primary cellular telephone number for the user.",
 			},
+
+			"user_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(graphrbac.Member),
+					string(graphrbac.Guest),
+				}, false),
+				Description: "The user type in the directory. One of `Guest` or `Member`.",
+			},
+
+			"employee_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 16),
+				Description:  "The employee identifier assigned to the user by the organisation.",
+			},
+
+			"employee_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Captures enterprise worker type, e.g. `Employee`, `Contractor`, `Consultant`, or `Vendor`.",
+			},
+
+			"other_mails": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Additional email addresses for the user.",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.StringIsEmailAddress,
+				},
+			},
+
+			"business_phones": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The telephone numbers for the user. Only one number can be set for this property.",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"fax_number": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The fax number of the user.",
+			},
+
+			"office_location": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"physical_delivery_office_name"},
+				Description:   "The office location in the user's place of business. This is an alias for `physical_delivery_office_name`.",
+			},
+
+			"preferred_language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The user's preferred language, in ISO 639-1 format, e.g. `en-US`.",
+			},
+
+			"show_in_address_list": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether or not the Outlook global address list should include this user.",
+			},
+
+			"age_group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Adult",
+					"Minor",
+					"NotAdult",
+				}, false),
+				Description: "The age group of the user, used for parental controls. One of `Adult`, `Minor` or `NotAdult`.",
+			},
+
+			"consent_provided_for_minor": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Denied",
+					"Granted",
+					"NotRequired",
+				}, false),
+				Description: "Whether consent has been obtained for minors. One of `Denied`, `Granted` or `NotRequired`.",
+			},
+
+			"creation_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates whether the user account was created as a regular school or work account (empty), an external account (`Invitation`), a local account for an Azure AD B2C tenant (`LocalAccount`) or self-service sign-up (`EmailVerified`).",
+			},
+
+			"onpremises_extension_attributes": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.OnPremisesExtensionAttributes,
+				Description:      "A map of extension attributes (`extensionAttribute1` through `extensionAttribute15`) for the user.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -278,8 +438,81 @@ func userResourceCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		userCreateParameters.AdditionalProperties["mobile"] = v.(string)
 	}
 
+	if v, ok := d.GetOk("user_type"); ok {
+		userCreateParameters.UserType = graphrbac.UserType(v.(string))
+	}
+
+	if v, ok := d.GetOk("employee_id"); ok {
+		userCreateParameters.AdditionalProperties["employeeId"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("employee_type"); ok {
+		userCreateParameters.AdditionalProperties["employeeType"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("other_mails"); ok {
+		userCreateParameters.AdditionalProperties["otherMails"] = *tf.ExpandStringSlicePtr(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("business_phones"); ok {
+		if phones := v.([]interface{}); len(phones) > 0 {
+			userCreateParameters.AdditionalProperties["telephoneNumber"] = phones[0].(string)
+		}
+	}
+
+	if v, ok := d.GetOk("fax_number"); ok {
+		userCreateParameters.AdditionalProperties["facsimileTelephoneNumber"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("office_location"); ok {
+		userCreateParameters.AdditionalProperties["physicalDeliveryOfficeName"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("preferred_language"); ok {
+		userCreateParameters.AdditionalProperties["preferredLanguage"] = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("show_in_address_list"); ok { //nolint:SA1019
+		userCreateParameters.AdditionalProperties["showInAddressList"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk("age_group"); ok {
+		userCreateParameters.AdditionalProperties["ageGroup"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("consent_provided_for_minor"); ok {
+		userCreateParameters.AdditionalProperties["consentProvidedForMinor"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("onpremises_extension_attributes"); ok {
+		userCreateParameters.AdditionalProperties["onPremisesExtensionAttributes"] = aadgraph.UserExpandOnPremisesExtensionAttributes(nil, v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("additional_properties"); ok {
+		additionalProperties, err := aadgraph.ExpandAdditionalProperties(v.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Parsing additional properties")
+		}
+		aadgraph.MergeAdditionalProperties(userCreateParameters.AdditionalProperties, additionalProperties)
+	}
+
+	if d.Get("adopt_existing").(bool) {
+		existing, err := client.Get(ctx, upn)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return tf.ErrorDiagPathF(err, "user_principal_name", "Could not check for existing user %q", upn)
+			}
+			log.Printf("[DEBUG] No existing user found with user principal name %q - creating a new user", upn)
+		} else if existing.ObjectID != nil && *existing.ObjectID != "" {
+			return userResourceAdopt(ctx, d, meta, *existing.ObjectID, userCreateParameters)
+		}
+	}
+
 	user, err := client.Create(ctx, userCreateParameters)
 	if err != nil {
+		if userPrincipalNameDomainNotVerified(user.Response) {
+			return userPrincipalNameDomainDiag(err, upn)
+		}
 		return tf.ErrorDiagF(err, "Creating user %q", upn)
 	}
 
@@ -300,6 +533,70 @@ func userResourceCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	return userResourceRead(ctx, d, meta)
 }
 
+// userResourceCustomizeDiff checks the domain of the user principal name against the domains for the tenant, so that
+// users cannot be planned with a domain that will be rejected by the API. Domains are not checked when they cannot be
+// listed, e.g. because the caller does not have permission to read them.
+func userResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("user_principal_name") || (diff.Id() != "" && !diff.HasChange("user_principal_name")) {
+		return nil
+	}
+
+	upn := diff.Get("user_principal_name").(string)
+	domainName := upn[strings.LastIndex(upn, "@")+1:]
+
+	domains, err := meta.(*clients.Client).Domains.TenantDomains(ctx)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to validate the domain for user principal name %q: %v", upn, err)
+		return nil
+	}
+
+	if aadgraph.DomainFindVerified(domains, domainName) == nil {
+		return fmt.Errorf("the domain %q for `user_principal_name` is not a verified domain for this tenant", domainName)
+	}
+
+	return nil
+}
+
+// userResourceAdopt takes over an existing user with the configured user principal name and updates it with the
+// properties that would otherwise have been used to create the user. The existing user may be a real account, so its
+// password is only reset when this has been explicitly requested, and its immutable ID is never changed.
+func userResourceAdopt(ctx context.Context, d *schema.ResourceData, meta interface{}, objectId string, properties graphrbac.UserCreateParameters) diag.Diagnostics {
+	client := meta.(*clients.Client).Users.AadClient
+
+	log.Printf("[DEBUG] Adopting existing user with object ID %q", objectId)
+
+	userUpdateParameters := graphrbac.UserUpdateParameters{
+		AccountEnabled:       properties.AccountEnabled,
+		DisplayName:          properties.DisplayName,
+		UsageLocation:        properties.UsageLocation,
+		GivenName:            properties.GivenName,
+		Surname:              properties.Surname,
+		UserType:             properties.UserType,
+		AdditionalProperties: properties.AdditionalProperties,
+	}
+
+	if d.Get("adopt_existing_reset_password").(bool) {
+		userUpdateParameters.PasswordProfile = properties.PasswordProfile
+	}
+
+	// the mail nickname of an existing user is only changed when it has been explicitly configured
+	if v, ok := d.GetOk("mail_nickname"); ok {
+		userUpdateParameters.MailNickname = utils.String(v.(string))
+	}
+
+	if len(userUpdateParameters.AdditionalProperties) == 0 {
+		userUpdateParameters.AdditionalProperties = nil
+	}
+
+	if _, err := client.Update(ctx, objectId, userUpdateParameters); err != nil {
+		return tf.ErrorDiagF(err, "Updating existing user with object ID: %q", objectId)
+	}
+
+	d.SetId(objectId)
+
+	return userResourceRead(ctx, d, meta)
+}
+
 func userResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Users.AadClient
 
@@ -382,11 +679,89 @@ func userResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		additionalProperties["mobile"] = d.Get("mobile").(string)
 	}
 
+	if d.HasChange("user_type") {
+		userUpdateParameters.UserType = graphrbac.UserType(d.Get("user_type").(string))
+	}
+
+	if d.HasChange("employee_id") {
+		additionalProperties["employeeId"] = d.Get("employee_id").(string)
+	}
+
+	if d.HasChange("employee_type") {
+		additionalProperties["employeeType"] = d.Get("employee_type").(string)
+	}
+
+	if d.HasChange("other_mails") {
+		additionalProperties["otherMails"] = *tf.ExpandStringSlicePtr(d.Get("other_mails").(*schema.Set).List())
+	}
+
+	if d.HasChange("user_principal_name") {
+		oldUpn, newUpn := d.GetChange("user_principal_name")
+		userUpdateParameters.UserPrincipalName = utils.String(newUpn.(string))
+
+		// the previous user principal name is kept as an alias so that mail addressed to it is still delivered, `other_mails`
+		// cannot be configured alongside this property so its value here is the one last read from the API
+		if d.Get("retain_previous_user_principal_name").(bool) {
+			otherMails := *tf.ExpandStringSlicePtr(d.Get("other_mails").(*schema.Set).List())
+			otherMails = append(otherMails, utils.Difference([]string{oldUpn.(string)}, otherMails)...)
+			additionalProperties["otherMails"] = otherMails
+		}
+	}
+
+	if d.HasChange("business_phones") {
+		var phone *string
+		if phones := d.Get("business_phones").([]interface{}); len(phones) > 0 {
+			phone = utils.String(phones[0].(string))
+		}
+		additionalProperties["telephoneNumber"] = phone
+	}
+
+	if d.HasChange("fax_number") {
+		additionalProperties["facsimileTelephoneNumber"] = d.Get("fax_number").(string)
+	}
+
+	if d.HasChange("office_location") {
+		additionalProperties["physicalDeliveryOfficeName"] = d.Get("office_location").(string)
+	}
+
+	if d.HasChange("preferred_language") {
+		additionalProperties["preferredLanguage"] = d.Get("preferred_language").(string)
+	}
+
+	if d.HasChange("show_in_address_list") {
+		additionalProperties["showInAddressList"] = d.Get("show_in_address_list").(bool)
+	}
+
+	if d.HasChange("age_group") {
+		additionalProperties["ageGroup"] = d.Get("age_group").(string)
+	}
+
+	if d.HasChange("consent_provided_for_minor") {
+		additionalProperties["consentProvidedForMinor"] = d.Get("consent_provided_for_minor").(string)
+	}
+
+	if d.HasChange("onpremises_extension_attributes") {
+		oldAttrs, newAttrs := d.GetChange("onpremises_extension_attributes")
+		additionalProperties["onPremisesExtensionAttributes"] = aadgraph.UserExpandOnPremisesExtensionAttributes(oldAttrs.(map[string]interface{}), newAttrs.(map[string]interface{}))
+	}
+
+	if d.HasChange("additional_properties") {
+		oldProps, newProps := d.GetChange("additional_properties")
+		changedProperties, err := aadgraph.ExpandAdditionalPropertiesChange(oldProps.(string), newProps.(string))
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Parsing additional properties")
+		}
+		aadgraph.MergeAdditionalProperties(additionalProperties, changedProperties)
+	}
+
 	if len(additionalProperties) > 0 {
 		userUpdateParameters.AdditionalProperties = additionalProperties
 	}
 
-	if _, err := client.Update(ctx, d.Id(), userUpdateParameters); err != nil {
+	if resp, err := client.Update(ctx, d.Id(), userUpdateParameters); err != nil {
+		if userPrincipalNameDomainNotVerified(resp) {
+			return userPrincipalNameDomainDiag(err, d.Get("user_principal_name").(string))
+		}
 		return tf.ErrorDiagF(err, "Updating User with object ID: %q", d.Id())
 	}
 
@@ -408,6 +783,18 @@ func userResourceRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		return tf.ErrorDiagF(err, "Retrieving user with object ID: %q", objectId)
 	}
 
+	additionalProperties := ""
+	if v := d.Get("additional_properties").(string); v != "" {
+		properties, err := aadgraph.UserObjectProperties(ctx, client, objectId)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Retrieving additional properties for user with object ID %q", objectId)
+		}
+		if additionalProperties, err = aadgraph.FlattenAdditionalProperties(properties, v); err != nil {
+			return tf.ErrorDiagPathF(err, "additional_properties", "Flattening additional properties for user with object ID %q", objectId)
+		}
+	}
+
+	tf.Set(d, "additional_properties", additionalProperties)
 	tf.Set(d, "object_id", user.ObjectID)
 	tf.Set(d, "immutable_id", user.ImmutableID)
 	tf.Set(d, "onpremises_sam_account_name", user.AdditionalProperties["onPremisesSamAccountName"])
@@ -420,6 +807,15 @@ func userResourceRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	tf.Set(d, "mail", user.Mail)
 	tf.Set(d, "mail_nickname", user.MailNickname)
 	tf.Set(d, "usage_location", user.UsageLocation)
+	tf.Set(d, "adopt_existing", d.Get("adopt_existing").(bool))
+	tf.Set(d, "adopt_existing_reset_password", d.Get("adopt_existing_reset_password").(bool))
+	tf.Set(d, "retain_previous_user_principal_name", d.Get("retain_previous_user_principal_name").(bool))
+
+	destroyBehavior := d.Get("destroy_behavior").(string)
+	if destroyBehavior == "" {
+		destroyBehavior = userDestroyBehaviorDelete
+	}
+	tf.Set(d, "destroy_behavior", destroyBehavior)
 
 	jobTitle := ""
 	if v, ok := user.AdditionalProperties["jobTitle"]; ok {
@@ -481,12 +877,31 @@ func userResourceRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	}
 	tf.Set(d, "mobile", mobile)
 
+	for k, v := range flattenUserExtendedProperties(user) {
+		tf.Set(d, k, v)
+	}
+
 	return nil
 }
 
 func userResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*clients.Client).Users.AadClient
 
+	if d.Get("destroy_behavior").(string) == userDestroyBehaviorDisable {
+		log.Printf("[INFO] Disabling user with object ID %q instead of deleting it", d.Id())
+		properties := graphrbac.UserUpdateParameters{
+			AccountEnabled: utils.Bool(false),
+		}
+		if resp, err := client.Update(ctx, d.Id(), properties); err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return tf.ErrorDiagF(err, "Disabling user with object ID: %q", d.Id())
+			}
+		}
+		return tf.DestroyBehaviorDiag("azuread_user", d.Id(), userDestroyBehaviorDisable, "disabled instead of being deleted")
+	}
+
+	log.Printf("[INFO] Deleting user with object ID %q", d.Id())
+
 	resp, err := client.Delete(ctx, d.Id())
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -496,3 +911,49 @@ func userResourceDelete(ctx context.Context, d *schema.ResourceData, meta interf
 
 	return nil
 }
+
+// userResourceImportResolve resolves the ID specified for an import, which can be either the object ID or the user
+// principal name of the user, to the object ID
+func userResourceImportResolve(ctx context.Context, id string, meta interface{}) (string, error) {
+	if _, err := uuid.ParseUUID(id); err == nil {
+		return id, nil
+	}
+
+	if !strings.Contains(id, "@") {
+		return "", fmt.Errorf("specified ID (%q) is not a valid object ID or user principal name", id)
+	}
+
+	client := meta.(*clients.Client).Users.AadClient
+
+	user, err := client.Get(ctx, id)
+	if err != nil {
+		if utils.ResponseWasNotFound(user.Response) {
+			return "", fmt.Errorf("no user found with user principal name %q", id)
+		}
+		return "", fmt.Errorf("retrieving user with user principal name %q: %+v", id, err)
+	}
+	if user.ObjectID == nil {
+		return "", fmt.Errorf("nil object ID for user with user principal name %q", id)
+	}
+
+	return *user.ObjectID, nil
+}
+
+// userPrincipalNameDomainNotVerified returns whether an API response indicates that the domain of a user principal
+// name is not one of the verified domains for the tenant
+func userPrincipalNameDomainNotVerified(resp autorest.Response) bool {
+	if !utils.ResponseWasStatusCode(resp, http.StatusBadRequest) {
+		return false
+	}
+	odata, err := aadgraph.NewOdataError(resp)
+	if err != nil || odata == nil {
+		return false
+	}
+	return aadgraph.OdataErrorContains(odata, "domain portion of the userPrincipalName") || aadgraph.OdataErrorContains(odata, "verified domain")
+}
+
+// userPrincipalNameDomainDiag returns a diagnostic for a user principal name which does not use a verified domain
+func userPrincipalNameDomainDiag(err error, upn string) diag.Diagnostics {
+	domain := upn[strings.LastIndex(upn, "@")+1:]
+	return tf.ErrorDiagPathF(err, "user_principal_name", "The domain %q is not a verified domain for this tenant, user principal names must use one of the verified domains listed by the `azuread_domains` data source", domain)
+}
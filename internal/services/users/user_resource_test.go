@@ -3,6 +3,7 @@ package users_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -31,6 +32,21 @@ func TestAccUser_basic(t *testing.T) {
 	})
 }
 
+func TestAccUser_importByUserPrincipalName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStepUsingAttribute("user_principal_name", "force_password_change", "password"),
+	})
+}
+
 func TestAccUser_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_user", "test")
 	r := UserResource{}
@@ -75,6 +91,61 @@ func TestAccUser_update(t *testing.T) {
 	})
 }
 
+func TestAccUser_updateUserPrincipalName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+		{
+			Config: r.renamed(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_principal_name").MatchesRegex(regexp.MustCompile(fmt.Sprintf(`^acctestUserRenamed\.%d@`, data.RandomInteger))),
+				check.That(data.ResourceName).Key("other_mails.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("force_password_change", "password", "retain_previous_user_principal_name"),
+	})
+}
+
+func TestAccUser_additionalProperties(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.additionalProperties(data, "DisablePasswordExpiration"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("additional_properties").HasValue(`{"passwordPolicies":"DisablePasswordExpiration"}`),
+			),
+		},
+		data.ImportStep("additional_properties", "force_password_change", "password"),
+		{
+			Config: r.additionalProperties(data, "DisableStrongPassword"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("additional_properties").HasValue(`{"passwordPolicies":"DisableStrongPassword"}`),
+			),
+		},
+		data.ImportStep("additional_properties", "force_password_change", "password"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("force_password_change", "password"),
+	})
+}
+
 func TestAccUser_threeUsersABC(t *testing.T) {
 	dataA := acceptance.BuildTestData(t, "azuread_user", "testA")
 	dataB := acceptance.BuildTestData(t, "azuread_user", "testB")
@@ -96,6 +167,89 @@ func TestAccUser_threeUsersABC(t *testing.T) {
 	})
 }
 
+func TestAccUser_adoptExisting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.adoptExisting(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				resource.TestCheckResourceAttrPair("azuread_user.adopted", "object_id", data.ResourceName, "object_id"),
+			),
+		},
+	})
+}
+
+func TestAccUser_adoptExistingResetPassword(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.adoptExistingResetPassword(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				resource.TestCheckResourceAttrPair("azuread_user.adopted", "object_id", data.ResourceName, "object_id"),
+				check.That("azuread_user.adopted").Key("adopt_existing_reset_password").HasValue("true"),
+			),
+		},
+	})
+}
+
+func TestAccUser_destroyBehaviorDisable(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTestWithCheckDestroy(t, r.checkDisabled, []resource.TestStep{
+		{
+			Config: r.destroyBehaviorDisable(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("destroy_behavior").HasValue("Disable"),
+			),
+		},
+		data.ImportStep("destroy_behavior", "force_password_change", "password"),
+	})
+}
+
+func TestAccUser_unverifiedDomain(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.unverifiedDomain(data),
+			ExpectError: regexp.MustCompile("is not a verified domain for this tenant"),
+		},
+	})
+}
+
+func TestAccUser_retainPreviousUserPrincipalNameWithOtherMails(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.renamedWithOtherMails(data),
+			ExpectError: regexp.MustCompile("conflicts with other_mails"),
+		},
+	})
+}
+
 func (r UserResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	resp, err := clients.Users.AadClient.Get(ctx, state.ID)
 
@@ -109,6 +263,32 @@ func (r UserResource) Exists(ctx context.Context, clients *clients.Client, state
 	return utils.Bool(resp.ObjectID != nil && *resp.ObjectID == state.ID), nil
 }
 
+// checkDisabled checks that destroyed users were disabled rather than deleted, and then deletes them
+func (UserResource) checkDisabled(s *terraform.State) error {
+	client := acceptance.AzureADProvider.Meta().(*clients.Client)
+	ctx := client.StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azuread_user" {
+			continue
+		}
+
+		user, err := client.Users.AadClient.Get(ctx, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("expected User with object ID %q to still exist after being destroyed: %+v", rs.Primary.ID, err)
+		}
+		if user.AccountEnabled == nil || *user.AccountEnabled {
+			return fmt.Errorf("expected User with object ID %q to be disabled after being destroyed", rs.Primary.ID)
+		}
+
+		if _, err := client.Users.AadClient.Delete(ctx, rs.Primary.ID); err != nil {
+			return fmt.Errorf("deleting disabled User with object ID %q: %+v", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
 func (UserResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 data "azuread_domains" "test" {
@@ -123,6 +303,24 @@ resource "azuread_user" "test" {
 `, data.RandomInteger, data.RandomPassword)
 }
 
+func (UserResource) additionalProperties(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+
+  additional_properties = jsonencode({
+    passwordPolicies = "%[3]s"
+  })
+}
+`, data.RandomInteger, data.RandomPassword, value)
+}
+
 func (UserResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 data "azuread_domains" "test" {
@@ -153,8 +351,23 @@ resource "azuread_user" "test" {
   mobile         = "(555) 555-5555"
 
   physical_delivery_office_name = "acctestUser-%[1]d-PDON"
+
+  user_type                  = "Member"
+  employee_id                = "%[3]s"
+  employee_type              = "Contractor"
+  other_mails                = ["acctestUser-%[1]d-alt@example.com"]
+  business_phones            = ["(555) 555-1234"]
+  fax_number                 = "(555) 555-4321"
+  preferred_language         = "en-GB"
+  show_in_address_list       = false
+  age_group                  = "Adult"
+  consent_provided_for_minor = "NotRequired"
+
+  onpremises_extension_attributes = {
+    extensionAttribute1 = "acctestUser-%[1]d-Ext1"
+  }
 }
-`, data.RandomInteger, data.RandomPassword)
+`, data.RandomInteger, data.RandomPassword, data.RandomString)
 }
 
 func (UserResource) threeUsersABC(data acceptance.TestData) string {
@@ -183,3 +396,87 @@ resource "azuread_user" "testC" {
 }
 `, data.RandomInteger, data.RandomPassword)
 }
+
+func (r UserResource) adoptExisting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user" "adopted" {
+  user_principal_name = azuread_user.test.user_principal_name
+  display_name        = azuread_user.test.display_name
+  password            = "%[2]s"
+  adopt_existing      = true
+}
+`, r.basic(data), data.RandomPassword)
+}
+
+func (r UserResource) adoptExistingResetPassword(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user" "adopted" {
+  user_principal_name           = azuread_user.test.user_principal_name
+  display_name                  = azuread_user.test.display_name
+  password                      = "%[2]s-reset"
+  force_password_change         = false
+  adopt_existing                = true
+  adopt_existing_reset_password = true
+}
+`, r.basic(data), data.RandomPassword)
+}
+
+func (UserResource) renamed(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name                 = "acctestUserRenamed.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name                        = "acctestUser-%[1]d"
+  password                            = "%[2]s"
+  retain_previous_user_principal_name = true
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (UserResource) destroyBehaviorDisable(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  destroy_behavior    = "Disable"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (UserResource) unverifiedDomain(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser.%[1]d@acctest-%[1]d.example.com"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (UserResource) renamedWithOtherMails(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name                 = "acctestUserRenamed.%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name                        = "acctestUser-%[1]d"
+  password                            = "%[2]s"
+  other_mails                         = ["acctestUser.%[1]d@hashicorptest.net"]
+  retain_previous_user_principal_name = true
+}
+`, data.RandomInteger, data.RandomPassword)
+}
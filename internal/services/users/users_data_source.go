@@ -121,6 +121,80 @@ func usersData() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+
+						"user_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"employee_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"employee_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"other_mails": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"business_phones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"fax_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"office_location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"preferred_language": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"show_in_address_list": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"age_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"consent_provided_for_minor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"creation_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"onpremises_extension_attributes": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 					},
 				},
 			},
@@ -214,6 +288,11 @@ func usersDataRead(ctx context.Context, d *schema.ResourceData, meta interface{}
 		user["onpremises_user_principal_name"] = u.AdditionalProperties["onPremisesUserPrincipalName"]
 		user["usage_location"] = u.UsageLocation
 		user["user_principal_name"] = u.UserPrincipalName
+
+		for k, v := range flattenUserExtendedProperties(*u) {
+			user[k] = v
+		}
+
 		userList = append(userList, user)
 	}
 
@@ -42,3 +42,14 @@ func ImportAsExistsDiag(resourceName, id string) diag.Diagnostics {
 		AttributePath: cty.Path{cty.GetAttrStep{Name: "id"}},
 	}}
 }
+
+// DestroyBehaviorDiag returns a warning describing the outcome of destroying a resource, when its `destroy_behavior`
+// is something other than deleting it, since this is not visible in the destroy plan
+func DestroyBehaviorDiag(resourceName, id, behavior, outcome string) diag.Diagnostics {
+	return diag.Diagnostics{diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       fmt.Sprintf("The %q with ID %q was %s", resourceName, id, outcome),
+		Detail:        fmt.Sprintf("This resource was destroyed with `destroy_behavior = %q`. Terraform plans show the resource as being destroyed regardless of this property, so this is the outcome that was applied.", behavior),
+		AttributePath: cty.Path{cty.GetAttrStep{Name: "destroy_behavior"}},
+	}}
+}
@@ -34,3 +34,30 @@ func ValidateResourceIDPriorToImportThen(idParser ResourceIDValidator, importer
 		},
 	}
 }
+
+// ResourceIDResolver takes the ID specified for an import, which may be a natural key for the
+// resource rather than a Resource ID, and returns the Resource ID
+type ResourceIDResolver func(ctx context.Context, id string, meta interface{}) (string, error)
+
+// ResolveResourceIDPriorToImport resolves the ID specified for an import to a Resource ID prior
+// to performing the import - allowing for resources to be imported using a natural key, such as
+// a user principal name, as well as using their Resource ID
+func ResolveResourceIDPriorToImport(resolver ResourceIDResolver) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			log.Printf("[DEBUG] Importing Resource - resolving %q", d.Id())
+
+			id, err := resolver(ctx, d.Id(), meta)
+			if err != nil {
+				return []*schema.ResourceData{d}, fmt.Errorf("resolving Resource ID %q: %+v", d.Id(), err)
+			}
+
+			if id != d.Id() {
+				log.Printf("[DEBUG] Importing Resource - resolved %q to %q", d.Id(), id)
+				d.SetId(id)
+			}
+
+			return schema.ImportStatePassthroughContext(ctx, d, meta)
+		},
+	}
+}
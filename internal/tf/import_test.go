@@ -0,0 +1,69 @@
+package tf
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResolveResourceIDPriorToImport(t *testing.T) {
+	resolver := func(ctx context.Context, id string, meta interface{}) (string, error) {
+		switch id {
+		case "natural-key":
+			return "00000000-0000-0000-0000-000000000000", nil
+		case "00000000-0000-0000-0000-000000000000":
+			return id, nil
+		}
+		return "", fmt.Errorf("no object found for %q", id)
+	}
+
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input:    "natural-key",
+			Expected: "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			Input:    "00000000-0000-0000-0000-000000000000",
+			Expected: "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			Input: "unknown",
+			Error: true,
+		},
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			d := r.Data(nil)
+			d.SetId(tc.Input)
+
+			result, err := ResolveResourceIDPriorToImport(resolver).StateContext(context.Background(), d, nil)
+			if err != nil {
+				if tc.Error {
+					return
+				}
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.Error {
+				t.Fatalf("expected an error for %q", tc.Input)
+			}
+
+			if len(result) != 1 {
+				t.Fatalf("expected 1 result, got %d", len(result))
+			}
+			if id := result[0].Id(); id != tc.Expected {
+				t.Fatalf("expected ID %q, got %q", tc.Expected, id)
+			}
+		})
+	}
+}
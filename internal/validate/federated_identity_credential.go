@@ -0,0 +1,82 @@
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const federatedIdentityCredentialSubjectMaxLength = 600
+
+// FederatedIdentityCredentialIssuer validates that the given string is a valid issuer URL for a federated identity
+// credential, i.e. an HTTPS URL with a host and without a query string or fragment
+func FederatedIdentityCredentialIssuer(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+	if ret = URLIsHTTPS(i, path); ret.HasError() {
+		return
+	}
+
+	u, err := url.Parse(i.(string))
+	if err != nil {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Issuer is in an invalid format",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+		return
+	}
+
+	if u.RawQuery != "" || u.Fragment != "" {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Issuer must not contain a query string or fragment",
+			AttributePath: path,
+		})
+	}
+
+	return
+}
+
+// FederatedIdentityCredentialSubject validates that the given string is a valid subject identifier for a federated
+// identity credential, i.e. not empty, no longer than 600 characters and without leading or trailing whitespace
+func FederatedIdentityCredentialSubject(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Subject must not be empty",
+			AttributePath: path,
+		})
+		return
+	}
+
+	if strings.TrimSpace(v) != v {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Subject must not have leading or trailing whitespace",
+			AttributePath: path,
+		})
+	}
+
+	if len(v) > federatedIdentityCredentialSubjectMaxLength {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Subject must be no longer than %d characters", federatedIdentityCredentialSubjectMaxLength),
+			AttributePath: path,
+		})
+	}
+
+	return
+}
@@ -0,0 +1,100 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestFederatedIdentityCredentialIssuer(t *testing.T) {
+	cases := []struct {
+		Issuer string
+		Errors int
+	}{
+		{
+			Issuer: "",
+			Errors: 1,
+		},
+		{
+			Issuer: "token.actions.githubusercontent.com",
+			Errors: 1,
+		},
+		{
+			Issuer: "http://token.actions.githubusercontent.com",
+			Errors: 1,
+		},
+		{
+			Issuer: "https://token.actions.githubusercontent.com?foo=bar",
+			Errors: 1,
+		},
+		{
+			Issuer: "https://token.actions.githubusercontent.com#foo",
+			Errors: 1,
+		},
+		{
+			Issuer: "https://token.actions.githubusercontent.com",
+			Errors: 0,
+		},
+		{
+			Issuer: "https://oidc.prod-aks.azure.com/00000000-0000-0000-0000-000000000000/",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Issuer, func(t *testing.T) {
+			diags := FederatedIdentityCredentialIssuer(tc.Issuer, cty.Path{})
+
+			if len(diags) != tc.Errors {
+				t.Fatalf("Expected FederatedIdentityCredentialIssuer to have %d not %d errors for %q", tc.Errors, len(diags), tc.Issuer)
+			}
+		})
+	}
+}
+
+func TestFederatedIdentityCredentialSubject(t *testing.T) {
+	cases := []struct {
+		Subject string
+		Errors  int
+	}{
+		{
+			Subject: "",
+			Errors:  1,
+		},
+		{
+			Subject: "   ",
+			Errors:  1,
+		},
+		{
+			Subject: " repo:hashicorp/terraform:ref:refs/heads/main",
+			Errors:  1,
+		},
+		{
+			Subject: strings.Repeat("a", 601),
+			Errors:  1,
+		},
+		{
+			Subject: strings.Repeat("a", 600),
+			Errors:  0,
+		},
+		{
+			Subject: "repo:hashicorp/terraform:ref:refs/heads/main",
+			Errors:  0,
+		},
+		{
+			Subject: "system:serviceaccount:default:workload-identity-sa",
+			Errors:  0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Subject, func(t *testing.T) {
+			diags := FederatedIdentityCredentialSubject(tc.Subject, cty.Path{})
+
+			if len(diags) != tc.Errors {
+				t.Fatalf("Expected FederatedIdentityCredentialSubject to have %d not %d errors for %q", tc.Errors, len(diags), tc.Subject)
+			}
+		})
+	}
+}
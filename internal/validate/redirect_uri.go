@@ -0,0 +1,213 @@
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RedirectUriMaxLength is the maximum length of a single redirect URI accepted by Azure Active Directory
+const RedirectUriMaxLength = 256
+
+// RedirectUriMaxCount is the maximum number of redirect URIs that can be configured for an application, across all
+// platforms
+const RedirectUriMaxCount = 256
+
+// redirectUriRules describes the platform-specific rules that apply to a redirect URI, in addition to those which apply
+// to all redirect URIs
+type redirectUriRules struct {
+	platform           string
+	allowCustomSchemes bool
+	allowWildcards     bool
+}
+
+// RedirectUriForWeb validates a redirect URI for the web platform. Only HTTPS URIs are accepted, with the exception of
+// HTTP URIs for the loopback address, and a wildcard is permitted in the leftmost DNS label of the host.
+func RedirectUriForWeb(i interface{}, path cty.Path) diag.Diagnostics {
+	return redirectUri(redirectUriRules{
+		platform:       "web",
+		allowWildcards: true,
+	})(i, path)
+}
+
+// RedirectUriForSpa validates a redirect URI for the single-page application platform. Only HTTPS URIs are accepted,
+// with the exception of HTTP URIs for the loopback address, and wildcards are not permitted.
+func RedirectUriForSpa(i interface{}, path cty.Path) diag.Diagnostics {
+	return redirectUri(redirectUriRules{
+		platform: "spa",
+	})(i, path)
+}
+
+// RedirectUriForPublicClient validates a redirect URI for the public client (mobile and desktop) platform. In addition
+// to HTTPS and loopback URIs, custom schemes such as `myapp://auth` and URNs such as `urn:ietf:wg:oauth:2.0:oob` are
+// accepted. Wildcards are not permitted.
+func RedirectUriForPublicClient(i interface{}, path cty.Path) diag.Diagnostics {
+	return redirectUri(redirectUriRules{
+		platform:           "public client",
+		allowCustomSchemes: true,
+	})(i, path)
+}
+
+func redirectUri(rules redirectUriRules) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+		v, ok := i.(string)
+		if !ok {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Expected a string value",
+				AttributePath: path,
+			})
+			return
+		}
+
+		if strings.TrimSpace(v) == "" {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Redirect URI must not be empty",
+				AttributePath: path,
+			})
+			return
+		}
+
+		if len(v) > RedirectUriMaxLength {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Redirect URI is too long",
+				Detail:        fmt.Sprintf("Redirect URIs can be at most %d characters long, got %d characters: %q", RedirectUriMaxLength, len(v), v),
+				AttributePath: path,
+			})
+			return
+		}
+
+		if strings.Contains(v, "#") {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Redirect URI must not contain a fragment",
+				Detail:        fmt.Sprintf("Authorization responses are delivered to the redirect URI, so it cannot contain a fragment component: %q", v),
+				AttributePath: path,
+			})
+			return
+		}
+
+		u, err := url.Parse(v)
+		if err != nil {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Redirect URI is in an invalid format",
+				Detail:        err.Error(),
+				AttributePath: path,
+			})
+			return
+		}
+
+		if u.Scheme == "" {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Redirect URI must be an absolute URI",
+				Detail:        fmt.Sprintf("Redirect URIs must include a scheme, such as `https://`: %q", v),
+				AttributePath: path,
+			})
+			return
+		}
+
+		scheme := strings.ToLower(u.Scheme)
+		switch scheme {
+		case "https":
+			if u.Host == "" {
+				ret = append(ret, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Redirect URI has no host",
+					AttributePath: path,
+				})
+				return
+			}
+
+		case "http":
+			if u.Host == "" {
+				ret = append(ret, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Redirect URI has no host",
+					AttributePath: path,
+				})
+				return
+			}
+			if !redirectUriIsLoopback(u) {
+				ret = append(ret, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Redirect URI must use HTTPS",
+					Detail:        fmt.Sprintf("The `http` scheme is only permitted for the loopback address (`localhost`, `127.0.0.1` or `[::1]`), got %q", v),
+					AttributePath: path,
+				})
+				return
+			}
+
+		default:
+			if !rules.allowCustomSchemes {
+				ret = append(ret, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Redirect URI must use HTTPS",
+					Detail:        fmt.Sprintf("Redirect URIs for the %s platform must use the `https` scheme, or `http` for the loopback address. Custom schemes are only permitted in the `public_client_platform` block, got %q", rules.platform, v),
+					AttributePath: path,
+				})
+				return
+			}
+		}
+
+		if strings.Contains(v, "*") {
+			if !rules.allowWildcards {
+				ret = append(ret, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Redirect URI must not contain a wildcard",
+					Detail:        fmt.Sprintf("Wildcard redirect URIs are only permitted in the `web` block, or the `reply_urls` property, got %q", v),
+					AttributePath: path,
+				})
+				return
+			}
+
+			if detail := redirectUriWildcardProblem(v, u, scheme); detail != "" {
+				ret = append(ret, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Redirect URI contains an invalid wildcard",
+					Detail:        fmt.Sprintf("%s, got %q", detail, v),
+					AttributePath: path,
+				})
+				return
+			}
+		}
+
+		return
+	}
+}
+
+// redirectUriIsLoopback returns whether the host of the URI is the loopback address
+func redirectUriIsLoopback(u *url.URL) bool {
+	switch strings.ToLower(u.Hostname()) {
+	case "localhost", "127.0.0.1", "::1":
+		return true
+	}
+	return false
+}
+
+// redirectUriWildcardProblem returns a description of why a wildcard in the URI is not permitted, or an empty string
+// if the wildcard is acceptable. A single wildcard is permitted, as the entire leftmost label of the host of an HTTPS
+// URI, and the remainder of the host must have at least two labels so that it cannot match an entire top-level domain.
+func redirectUriWildcardProblem(v string, u *url.URL, scheme string) string {
+	if scheme != "https" {
+		return "Wildcards are only permitted in redirect URIs using the `https` scheme"
+	}
+
+	host := u.Hostname()
+	if strings.Count(v, "*") != 1 || !strings.HasPrefix(host, "*.") {
+		return "A single wildcard is permitted, and only as the leftmost label of the host, e.g. `https://*.example.com`"
+	}
+
+	if labels := strings.Split(strings.TrimPrefix(host, "*."), "."); len(labels) < 2 {
+		return "Wildcards cannot be used to match an entire top-level domain"
+	}
+
+	return ""
+}
@@ -0,0 +1,194 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestRedirectUriForWeb(t *testing.T) {
+	cases := []struct {
+		Uri    string
+		Errors int
+	}{
+		{
+			Uri:    "",
+			Errors: 1,
+		},
+		{
+			Uri:    "www.example.com/callback",
+			Errors: 1,
+		},
+		{
+			Uri:    "https://www.example.com/callback",
+			Errors: 0,
+		},
+		{
+			Uri:    "https://www.example.com/callback?foo=bar",
+			Errors: 0,
+		},
+		{
+			Uri:    "https://www.example.com/callback#foo",
+			Errors: 1,
+		},
+		{
+			Uri:    "http://www.example.com/callback",
+			Errors: 1,
+		},
+		{
+			Uri:    "http://localhost:8080/callback",
+			Errors: 0,
+		},
+		{
+			Uri:    "http://127.0.0.1/callback",
+			Errors: 0,
+		},
+		{
+			Uri:    "http://[::1]:3000/callback",
+			Errors: 0,
+		},
+		{
+			Uri:    "myapp://auth",
+			Errors: 1,
+		},
+		{
+			Uri:    "urn:ietf:wg:oauth:2.0:oob",
+			Errors: 1,
+		},
+		{
+			Uri:    "https://*.example.com/callback",
+			Errors: 0,
+		},
+		{
+			Uri:    "https://*.com/callback",
+			Errors: 1,
+		},
+		{
+			Uri:    "https://foo.*.example.com/callback",
+			Errors: 1,
+		},
+		{
+			Uri:    "https://*.*.example.com/callback",
+			Errors: 1,
+		},
+		{
+			Uri:    "https://www.example.com/*",
+			Errors: 1,
+		},
+		{
+			Uri:    "http://*.localhost/callback",
+			Errors: 1,
+		},
+		{
+			Uri:    "https://www.example.com/" + strings.Repeat("a", RedirectUriMaxLength),
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Uri, func(t *testing.T) {
+			diags := RedirectUriForWeb(tc.Uri, cty.Path{})
+
+			if len(diags) != tc.Errors {
+				t.Fatalf("Expected RedirectUriForWeb to have %d not %d errors for %q", tc.Errors, len(diags), tc.Uri)
+			}
+		})
+	}
+}
+
+func TestRedirectUriForSpa(t *testing.T) {
+	cases := []struct {
+		Uri    string
+		Errors int
+	}{
+		{
+			Uri:    "https://www.example.com/",
+			Errors: 0,
+		},
+		{
+			Uri:    "http://localhost:3000/",
+			Errors: 0,
+		},
+		{
+			Uri:    "http://www.example.com/",
+			Errors: 1,
+		},
+		{
+			Uri:    "https://*.example.com/",
+			Errors: 1,
+		},
+		{
+			Uri:    "https://www.example.com/#/signin",
+			Errors: 1,
+		},
+		{
+			Uri:    "myapp://auth",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Uri, func(t *testing.T) {
+			diags := RedirectUriForSpa(tc.Uri, cty.Path{})
+
+			if len(diags) != tc.Errors {
+				t.Fatalf("Expected RedirectUriForSpa to have %d not %d errors for %q", tc.Errors, len(diags), tc.Uri)
+			}
+		})
+	}
+}
+
+func TestRedirectUriForPublicClient(t *testing.T) {
+	cases := []struct {
+		Uri    string
+		Errors int
+	}{
+		{
+			Uri:    "myapp://auth",
+			Errors: 0,
+		},
+		{
+			Uri:    "msal00000000-0000-0000-0000-000000000000://auth",
+			Errors: 0,
+		},
+		{
+			Uri:    "urn:ietf:wg:oauth:2.0:oob",
+			Errors: 0,
+		},
+		{
+			Uri:    "https://login.microsoftonline.com/common/oauth2/nativeclient",
+			Errors: 0,
+		},
+		{
+			Uri:    "http://localhost",
+			Errors: 0,
+		},
+		{
+			Uri:    "http://www.example.com",
+			Errors: 1,
+		},
+		{
+			Uri:    "https://*.example.com",
+			Errors: 1,
+		},
+		{
+			Uri:    "myapp://auth#foo",
+			Errors: 1,
+		},
+		{
+			Uri:    "/relative/path",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Uri, func(t *testing.T) {
+			diags := RedirectUriForPublicClient(tc.Uri, cty.Path{})
+
+			if len(diags) != tc.Errors {
+				t.Fatalf("Expected RedirectUriForPublicClient to have %d not %d errors for %q", tc.Errors, len(diags), tc.Uri)
+			}
+		})
+	}
+}
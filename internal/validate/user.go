@@ -0,0 +1,47 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+var extensionAttributeKeyRegex = regexp.MustCompile(`^extensionAttribute([1-9]|1[0-5])$`)
+
+// OnPremisesExtensionAttributes validates that the keys of a map are valid extension attribute names
+// (extensionAttribute1 through extensionAttribute15) and that the values are not empty
+func OnPremisesExtensionAttributes(i interface{}, path cty.Path) (ret diag.Diagnostics) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a map value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	for k, val := range v {
+		if !extensionAttributeKeyRegex.MatchString(k) {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid extension attribute name %q", k),
+				Detail:        "Extension attribute names must be in the format `extensionAttributeN`, where N is a number from 1 to 15",
+				AttributePath: path,
+			})
+			continue
+		}
+
+		if s, ok := val.(string); !ok || s == "" {
+			ret = append(ret, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Value for extension attribute %q must not be empty", k),
+				AttributePath: path,
+			})
+		}
+	}
+
+	return
+}
@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestOnPremisesExtensionAttributes(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{},
+			TestName: "Empty",
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"extensionAttribute1": "foo", "extensionAttribute15": "bar"},
+			TestName: "Valid",
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"extensionAttribute0": "foo"},
+			TestName: "OutOfRangeLow",
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"extensionAttribute16": "foo"},
+			TestName: "OutOfRangeHigh",
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"ExtensionAttribute1": "foo"},
+			TestName: "WrongCase",
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"extensionAttribute2": ""},
+			TestName: "EmptyValue",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			diags := OnPremisesExtensionAttributes(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected OnPremisesExtensionAttributes to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.TestName)
+			}
+		})
+	}
+}